@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -16,12 +17,20 @@ import (
 	"github.com/nglogic/go-application-guide/internal/adapter/database"
 	"github.com/nglogic/go-application-guide/internal/adapter/http/incidents"
 	"github.com/nglogic/go-application-guide/internal/adapter/http/weather"
+	"github.com/nglogic/go-application-guide/internal/adapter/tracing"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/bikes"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/customers"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/discount"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/maintenance"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/promocodes"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental/reservation"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/stations"
+	"github.com/nglogic/go-application-guide/internal/transport/admin"
 	"github.com/nglogic/go-application-guide/internal/transport/grpc"
 	"github.com/nglogic/go-application-guide/internal/transport/grpc/httpgateway"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -43,6 +52,17 @@ func main() {
 	}
 	log.SetLevel(logLevel)
 
+	tracerProvider, err := tracing.NewProvider(context.Background(), conf.TracingExporter, conf.OTLPEndpoint, conf.TracingServiceName)
+	if err != nil {
+		log.Fatalf("creating tracer provider: %v", err)
+	}
+	defer func() {
+		if err := tracerProvider.Shutdown(context.Background()); err != nil {
+			log.Errorf("shutting down tracer provider: %v", err)
+		}
+	}()
+	otel.SetTracerProvider(tracerProvider)
+
 	dbAdapter, err := database.NewAdapter(conf.PostgresHostPort, conf.PostgresDB, conf.PostgresUser, conf.PostgresPass, conf.PostgresMigrationsDir, log)
 	if err != nil {
 		log.Fatalf("creating bike repository: %v", err)
@@ -53,6 +73,31 @@ func main() {
 		log.Fatalf("creating bike service: %v", err)
 	}
 
+	stationService, err := stations.NewService(dbAdapter.Stations())
+	if err != nil {
+		log.Fatalf("creating station service: %v", err)
+	}
+
+	customerService, err := customers.NewService(dbAdapter.Customers())
+	if err != nil {
+		log.Fatalf("creating customer service: %v", err)
+	}
+
+	metricProvider, metricsHandler, err := newMetricsProvider(conf, log)
+	if err != nil {
+		log.Fatalf("creating metrics provider: %v", err)
+	}
+
+	businessMetrics, err := metrics.NewBusiness(metricProvider)
+	if err != nil {
+		log.Fatalf("creating business metrics: %v", err)
+	}
+
+	maintenanceService, err := maintenance.NewService(bikeService, dbAdapter.Maintenance(), businessMetrics)
+	if err != nil {
+		log.Fatalf("creating maintenance service: %v", err)
+	}
+
 	httpClient := &http.Client{
 		Timeout: maxHTTPClientTimeout,
 	}
@@ -67,24 +112,53 @@ func main() {
 		log.Fatalf("creating incidents adapter: %v", err)
 	}
 
-	discountService, err := discount.NewService(weatherAdapter, incidentsAdapter)
+	discountService, err := discount.NewService(
+		weatherAdapter,
+		incidentsAdapter,
+		businessMetrics,
+		tracing.NewTracer(tracerProvider, "github.com/nglogic/go-application-guide/internal/app/bikerental/discount"),
+		discount.NewRegistry(),
+		conf.DiscountRulesFile,
+	)
 	if err != nil {
 		log.Fatalf("creating discount service: %v", err)
 	}
 
+	promoCodeService, err := promocodes.NewService(dbAdapter.PromoCodes())
+	if err != nil {
+		log.Fatalf("creating promo code service: %v", err)
+	}
+
 	reservationService, err := reservation.NewService(
 		discountService,
 		bikeService,
+		promoCodeService,
+		stationService,
+		maintenanceService,
 		dbAdapter.Reservations(),
 		dbAdapter.Customers(),
+		dbAdapter.Waitlist(),
+		businessMetrics,
+		tracing.NewTracer(tracerProvider, "github.com/nglogic/go-application-guide/internal/app/bikerental/reservation"),
+		conf.LateFeePerHour,
+		conf.MaxStationDistance,
+		conf.HoldDuration,
+		bikerental.CancellationPolicies{
+			bikerental.CustomerTypeIndividual: {
+				FreePeriod: conf.IndividualFreeCancellationPeriod,
+				FeePercent: conf.IndividualCancellationFeePercent,
+			},
+			bikerental.CustomerTypeBusiness: {
+				FreePeriod: conf.BusinessFreeCancellationPeriod,
+				FeePercent: conf.BusinessCancellationFeePercent,
+			},
+		},
 	)
 	if err != nil {
 		log.Fatalf("creating reservation service: %v", err)
 	}
 
-	metricProvider := metrics.NewDummy(log)
-
-	srv, err := grpc.NewServer(bikeService, reservationService, log)
+	srv, err := grpc.NewServer(bikeService, stationService, reservationService, maintenanceService, customerService, promoCodeService, log)
 	if err != nil {
 		log.Fatalf("creating new server: %v", err)
 	}
@@ -115,6 +189,24 @@ func main() {
 		}
 		return nil
 	})
+	g.Go(func() error {
+		runHoldReaper(ctx, log, reservationService, conf.HoldReaperInterval)
+		return nil
+	})
+	if metricsHandler != nil {
+		g.Go(func() error {
+			if err := admin.RunServer(ctx, log, metricsHandler, conf.AdminServerAddr); err != nil {
+				return fmt.Errorf("admin server: %w", err)
+			}
+			return nil
+		})
+	}
+	if flusher, ok := metricProvider.(metrics.Flusher); ok {
+		g.Go(func() error {
+			metrics.RunFlusher(ctx, flusher, conf.MetricsFlushInterval)
+			return nil
+		})
+	}
 	if err := g.Wait(); err != nil {
 		log.Error(err)
 	}
@@ -125,6 +217,21 @@ type config struct {
 	GRPCServerAddr string `env:"GRPC_SERVER_ADDR" envDefault:":9090"`
 	LogLevel       string `env:"LOG_LEVEL" envDefault:"info"`
 
+	// AdminServerAddr is an address of admin http server, exposing metrics for Prometheus.
+	AdminServerAddr string `env:"ADMIN_SERVER_ADDR" envDefault:":8081"`
+
+	// MetricsProvider is one of: dummy, prometheus. Prometheus metrics are served by admin server.
+	MetricsProvider  string `env:"METRICS_PROVIDER" envDefault:"dummy"`
+	MetricsNamespace string `env:"METRICS_NAMESPACE" envDefault:"bikerental"`
+
+	// MetricsFlushInterval is an interval of flushing metrics, for providers that buffer them.
+	MetricsFlushInterval time.Duration `env:"METRICS_FLUSH_INTERVAL" envDefault:"1m"`
+
+	// TracingExporter is one of: none, stdout, otlp.
+	TracingExporter    string `env:"TRACING_EXPORTER" envDefault:"none"`
+	OTLPEndpoint       string `env:"OTLP_ENDPOINT" envDefault:"localhost:4317"`
+	TracingServiceName string `env:"TRACING_SERVICE_NAME" envDefault:"bikerental"`
+
 	PostgresDB            string `env:"POSTGRES_DB" envDefault:"testdb"`
 	PostgresUser          string `env:"POSTGRES_USER" envDefault:"postgres"`
 	PostgresPass          string `env:"POSTGRES_PASS" envDefault:"password"`
@@ -136,6 +243,66 @@ type config struct {
 
 	BikewiseAddr    string        `env:"BIKEWISE_ADDR" envDefault:"https://bikewise.org/api"`
 	BikewiseTimeout time.Duration `env:"BIKEWISE_TIMEOUT" envDefault:"10s"`
+
+	DiscountRulesFile string `env:"DISCOUNT_RULES_FILE" envDefault:"configs/discount/rules.json"`
+
+	// LateFeePerHour is a penalty in euro-cents for every started hour of late bike return.
+	LateFeePerHour int `env:"LATE_FEE_PER_HOUR" envDefault:"500"`
+
+	// MaxStationDistance is a max distance in meters between rental location and bike station.
+	MaxStationDistance float64 `env:"MAX_STATION_DISTANCE" envDefault:"1000"`
+
+	// HoldDuration is a time the bike is held for the customer before the reservation has to be confirmed.
+	HoldDuration time.Duration `env:"HOLD_DURATION" envDefault:"10m"`
+
+	// HoldReaperInterval is an interval of expiring stale bike holds.
+	HoldReaperInterval time.Duration `env:"HOLD_REAPER_INTERVAL" envDefault:"1m"`
+
+	// Cancellation is free until free cancellation period before reservation start.
+	// Later, cancellation fee percent of reservation value is charged, and after the start nothing is refunded.
+	IndividualFreeCancellationPeriod time.Duration `env:"INDIVIDUAL_FREE_CANCELLATION_PERIOD" envDefault:"24h"`
+	IndividualCancellationFeePercent int           `env:"INDIVIDUAL_CANCELLATION_FEE_PERCENT" envDefault:"50"`
+	BusinessFreeCancellationPeriod   time.Duration `env:"BUSINESS_FREE_CANCELLATION_PERIOD" envDefault:"2h"`
+	BusinessCancellationFeePercent   int           `env:"BUSINESS_CANCELLATION_FEE_PERCENT" envDefault:"25"`
+}
+
+// newMetricsProvider creates metrics provider chosen in config.
+// Returned handler exposes metrics on admin server, it's nil if the provider doesn't expose them.
+func newMetricsProvider(conf config, log logrus.FieldLogger) (metrics.Provider, http.Handler, error) {
+	switch conf.MetricsProvider {
+	case "prometheus":
+		p, err := metrics.NewPrometheus(conf.MetricsNamespace, log)
+		if err != nil {
+			return nil, nil, err
+		}
+		return p, p.Handler(), nil
+	case "dummy":
+		return metrics.NewDummy(log), nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown metrics provider '%s'", conf.MetricsProvider)
+	}
+}
+
+// runHoldReaper periodically expires stale bike holds, until context is done.
+func runHoldReaper(ctx context.Context, log logrus.FieldLogger, reservationService *reservation.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			resp, err := reservationService.ExpireHolds(ctx)
+			if err != nil {
+				log.Errorf("expiring reservation holds: %v", err)
+				continue
+			}
+			for _, r := range resp.Promoted {
+				log.Infof("waitlist entry promoted to reservation %s after hold expired", r.ID)
+			}
+		}
+	}
 }
 
 func newConfig() (config, error) {
@@ -143,5 +310,13 @@ func newConfig() (config, error) {
 	if err := env.Parse(&cfg); err != nil {
 		return cfg, fmt.Errorf("decoding config from env: %w", err)
 	}
+
+	// Tickers panic on non positive intervals.
+	if cfg.HoldReaperInterval <= 0 {
+		return cfg, errors.New("HOLD_REAPER_INTERVAL has to be positive")
+	}
+	if cfg.MetricsFlushInterval <= 0 {
+		return cfg, errors.New("METRICS_FLUSH_INTERVAL has to be positive")
+	}
 	return cfg, nil
 }
@@ -0,0 +1,51 @@
+// Command mergecustomers merges customers with duplicated emails.
+//
+// Before emails were unique, every reservation made without customer id created a new customer.
+// Migration which adds unique email index merges remaining duplicates by itself, the same way.
+// This command can be run before upgrading, to merge them without applying any migrations.
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/nglogic/go-application-guide/internal/adapter/database"
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	log := logrus.New()
+
+	conf, err := newConfig()
+	if err != nil {
+		log.Fatalf("initializing config: %v", err)
+	}
+
+	dbAdapter, err := database.NewAdapter(conf.PostgresHostPort, conf.PostgresDB, conf.PostgresUser, conf.PostgresPass, "", log)
+	if err != nil {
+		log.Fatalf("creating db adapter: %v", err)
+	}
+	defer dbAdapter.Close()
+
+	deleted, err := dbAdapter.Customers().MergeDuplicates(context.Background())
+	if err != nil {
+		log.Fatalf("merging customers: %v", err)
+	}
+	log.Infof("merged duplicated customers, %d customers deleted", deleted)
+}
+
+type config struct {
+	PostgresDB       string `env:"POSTGRES_DB" envDefault:"testdb"`
+	PostgresUser     string `env:"POSTGRES_USER" envDefault:"postgres"`
+	PostgresPass     string `env:"POSTGRES_PASS" envDefault:"password"`
+	PostgresHostPort string `env:"POSTGRES_HOSTPORT" envDefault:"localhost:5432"`
+}
+
+func newConfig() (config, error) {
+	cfg := config{}
+	if err := env.Parse(&cfg); err != nil {
+		return cfg, fmt.Errorf("decoding config from env: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1 @@
+ALTER TABLE reservations ADD COLUMN discount_rule varchar NOT NULL DEFAULT '';
@@ -0,0 +1,25 @@
+CREATE TABLE promo_codes (
+	code varchar NOT NULL,
+	discount_percent numeric NOT NULL DEFAULT 0,
+	discount_amount integer NOT NULL DEFAULT 0,
+	valid_from timestamptz(0) NOT NULL,
+	valid_to timestamptz(0) NOT NULL,
+	max_redemptions integer NOT NULL DEFAULT 0,
+	max_redemptions_per_customer integer NOT NULL DEFAULT 0,
+	stackable boolean NOT NULL DEFAULT false,
+	disabled boolean NOT NULL DEFAULT false,
+	CONSTRAINT promo_codes_pk PRIMARY KEY (code)
+);
+
+CREATE TABLE promo_code_redemptions (
+	code varchar NOT NULL,
+	reservation_id uuid NOT NULL,
+	customer_id uuid NOT NULL,
+	redeemed_at timestamptz(0) NOT NULL DEFAULT now(),
+	CONSTRAINT promo_code_redemptions_pk PRIMARY KEY (code, reservation_id),
+	CONSTRAINT promo_codes_fk FOREIGN KEY (code) REFERENCES promo_codes(code) ON UPDATE CASCADE ON DELETE RESTRICT,
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE
+);
+CREATE INDEX promo_code_redemptions_customer_idx ON public.promo_code_redemptions USING btree (code, customer_id);
+
+ALTER TABLE reservations ADD COLUMN promo_code varchar NOT NULL DEFAULT '';
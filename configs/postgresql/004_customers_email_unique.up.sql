@@ -0,0 +1,24 @@
+-- Customers with duplicated emails are merged before adding the index, the same way cmd/mergecustomers does it.
+-- Customer with the most reservations is kept, reservations and promo code redemptions of other customers
+-- are moved to it, and other customers are deleted.
+CREATE TEMPORARY TABLE customer_merges ON COMMIT DROP AS
+SELECT duplicate_id, keep_id FROM (
+	SELECT
+		c.id AS duplicate_id,
+		first_value(c.id) OVER (
+			PARTITION BY lower(c.email)
+			ORDER BY coalesce(r.n, 0) DESC, c.id ASC
+		) AS keep_id
+	FROM customers c
+	LEFT JOIN (
+		SELECT customer_id, count(*) AS n FROM reservations GROUP BY customer_id
+	) r ON r.customer_id = c.id
+	WHERE c.email <> ''
+) m
+WHERE duplicate_id <> keep_id;
+
+UPDATE reservations r SET customer_id = m.keep_id FROM customer_merges m WHERE r.customer_id = m.duplicate_id;
+UPDATE promo_code_redemptions pr SET customer_id = m.keep_id FROM customer_merges m WHERE pr.customer_id = m.duplicate_id;
+DELETE FROM customers c USING customer_merges m WHERE c.id = m.duplicate_id;
+
+CREATE UNIQUE INDEX customers_email_unique_idx ON public.customers USING btree (lower(email)) WHERE email <> '';
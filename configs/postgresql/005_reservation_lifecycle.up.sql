@@ -0,0 +1,6 @@
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'active';
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'completed';
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'no_show';
+
+ALTER TABLE reservations ADD COLUMN picked_up_at timestamptz(0) NULL;
+ALTER TABLE reservations ADD COLUMN returned_at timestamptz(0) NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE invoices (
+	reservation_id uuid NOT NULL,
+	reservation_value integer NOT NULL,
+	discount integer NOT NULL,
+	late_fee integer NOT NULL,
+	late_hours integer NOT NULL,
+	total_value integer NOT NULL,
+	created_at timestamptz(0) NOT NULL,
+	CONSTRAINT invoices_pk PRIMARY KEY (reservation_id),
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
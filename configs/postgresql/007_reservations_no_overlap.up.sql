@@ -0,0 +1,8 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Makes double-booking impossible even for concurrent transactions.
+-- Statuses have to match ones ignored in bike availability checks.
+ALTER TABLE reservations ADD CONSTRAINT reservations_bike_no_overlap EXCLUDE USING gist (
+	bike_id WITH =,
+	tstzrange(start_time, end_time, '[)') WITH &&
+) WHERE ("status" NOT IN ('canceled', 'no_show'));
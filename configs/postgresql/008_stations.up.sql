@@ -0,0 +1,21 @@
+CREATE TABLE stations (
+	id uuid NOT NULL,
+	"name" varchar NOT NULL,
+	lat numeric NOT NULL,
+	long numeric NOT NULL,
+	capacity integer NOT NULL,
+	CONSTRAINT stations_pk PRIMARY KEY (id)
+);
+
+CREATE TYPE bike_status AS ENUM (
+	'available',
+	'maintenance',
+	'retired'
+);
+
+ALTER TABLE bikes ADD COLUMN serial_number varchar NOT NULL DEFAULT '';
+ALTER TABLE bikes ADD COLUMN station_id uuid NULL;
+ALTER TABLE bikes ADD COLUMN "status" bike_status NOT NULL DEFAULT 'available';
+ALTER TABLE bikes ADD CONSTRAINT stations_fk FOREIGN KEY (station_id) REFERENCES stations(id) ON UPDATE CASCADE ON DELETE RESTRICT;
+CREATE UNIQUE INDEX bikes_serial_number_unique_idx ON public.bikes USING btree (serial_number) WHERE serial_number <> '';
+CREATE INDEX bikes_station_idx ON public.bikes USING btree (station_id);
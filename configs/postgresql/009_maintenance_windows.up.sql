@@ -0,0 +1,10 @@
+CREATE TABLE maintenance_windows (
+	id uuid NOT NULL,
+	bike_id uuid NOT NULL,
+	start_time timestamptz(0) NOT NULL,
+	end_time timestamptz(0) NOT NULL,
+	reason varchar NOT NULL DEFAULT '',
+	CONSTRAINT maintenance_windows_pk PRIMARY KEY (id),
+	CONSTRAINT bikes_fk FOREIGN KEY (bike_id) REFERENCES bikes(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX maintenance_windows_bike_timerange_idx ON public.maintenance_windows USING btree (bike_id, start_time, end_time);
@@ -0,0 +1,2 @@
+ALTER TABLE reservations ADD COLUMN series_id uuid NULL;
+CREATE INDEX reservations_series_id_idx ON public.reservations USING btree (series_id);
@@ -0,0 +1,2 @@
+ALTER TABLE reservations ADD COLUMN group_id uuid NULL;
+CREATE INDEX reservations_group_id_idx ON public.reservations USING btree (group_id);
@@ -0,0 +1,39 @@
+CREATE TYPE waitlist_status AS ENUM (
+	'waiting',
+	'promoted',
+	'withdrawn'
+);
+
+CREATE TABLE waitlist_entries (
+	id uuid NOT NULL,
+	"status" waitlist_status NOT NULL DEFAULT 'waiting',
+	bike_id uuid NOT NULL,
+	customer_id uuid NOT NULL,
+	lat numeric NOT NULL,
+	long numeric NOT NULL,
+	start_time timestamptz(0) NOT NULL,
+	end_time timestamptz(0) NOT NULL,
+	total_value integer NOT NULL,
+	applied_discount integer NOT NULL,
+	discount_rule varchar NOT NULL DEFAULT '',
+	reservation_id uuid NULL,
+	created_at timestamptz(0) NOT NULL DEFAULT now(),
+	CONSTRAINT waitlist_entries_pk PRIMARY KEY (id),
+	CONSTRAINT bikes_fk FOREIGN KEY (bike_id) REFERENCES bikes(id) ON UPDATE CASCADE ON DELETE CASCADE,
+	CONSTRAINT customers_fk FOREIGN KEY (customer_id) REFERENCES customers(id) ON UPDATE CASCADE ON DELETE RESTRICT DEFERRABLE,
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE SET NULL DEFERRABLE
+);
+CREATE INDEX waitlist_entries_bike_status_idx ON public.waitlist_entries USING btree (bike_id, "status", created_at);
+
+CREATE TABLE notifications (
+	id uuid NOT NULL,
+	customer_id uuid NOT NULL,
+	kind varchar NOT NULL,
+	message varchar NOT NULL,
+	reservation_id uuid NULL,
+	created_at timestamptz(0) NOT NULL DEFAULT now(),
+	CONSTRAINT notifications_pk PRIMARY KEY (id),
+	CONSTRAINT customers_fk FOREIGN KEY (customer_id) REFERENCES customers(id) ON UPDATE CASCADE ON DELETE CASCADE,
+	CONSTRAINT reservations_fk FOREIGN KEY (reservation_id) REFERENCES reservations(id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+CREATE INDEX notifications_customer_idx ON public.notifications USING btree (customer_id, created_at);
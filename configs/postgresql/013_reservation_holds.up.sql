@@ -0,0 +1,5 @@
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'pending';
+ALTER TYPE reservation_status ADD VALUE IF NOT EXISTS 'expired';
+
+ALTER TABLE reservations ADD COLUMN hold_expires_at timestamptz(0) NULL;
+CREATE INDEX reservations_hold_expires_at_idx ON public.reservations USING btree (hold_expires_at);
@@ -0,0 +1,7 @@
+-- New enum values can't be used in the migration that adds them, so the constraint is replaced separately.
+-- Statuses have to match ones ignored in bike availability checks.
+ALTER TABLE reservations DROP CONSTRAINT reservations_bike_no_overlap;
+ALTER TABLE reservations ADD CONSTRAINT reservations_bike_no_overlap EXCLUDE USING gist (
+	bike_id WITH =,
+	tstzrange(start_time, end_time, '[)') WITH &&
+) WHERE ("status" NOT IN ('canceled', 'no_show', 'expired'));
@@ -0,0 +1,3 @@
+ALTER TABLE reservations ADD COLUMN refund integer NOT NULL DEFAULT 0;
+ALTER TABLE reservations ADD COLUMN cancellation_fee integer NOT NULL DEFAULT 0;
+ALTER TABLE reservations ADD COLUMN canceled_at timestamptz(0) NULL;
@@ -0,0 +1,15 @@
+-- Redemptions counter is incremented together with a limit check, so concurrent redemptions can't exceed the limit.
+ALTER TABLE promo_codes ADD COLUMN redemptions integer NOT NULL DEFAULT 0;
+UPDATE promo_codes p SET redemptions = (SELECT count(*) FROM promo_code_redemptions pr WHERE pr.code = p.code);
+
+-- Released redemptions are deleted by expired holds and together with their reservations,
+-- so the counter is decremented by a trigger to cover all of them.
+CREATE FUNCTION promo_code_redemption_released() RETURNS trigger AS $$
+BEGIN
+	UPDATE promo_codes SET redemptions = redemptions - 1 WHERE code = OLD.code;
+	RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER promo_code_redemptions_release AFTER DELETE ON promo_code_redemptions
+	FOR EACH ROW EXECUTE PROCEDURE promo_code_redemption_released();
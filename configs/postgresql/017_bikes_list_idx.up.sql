@@ -0,0 +1,2 @@
+-- Matches bikes list sort order, where bikes without model name are sorted as if the name was empty.
+CREATE INDEX bikes_list_idx ON public.bikes USING btree (coalesce(model_name, ''), id);
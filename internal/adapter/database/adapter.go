@@ -1,11 +1,11 @@
 package database
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 
-	// Needed for registering postgres drivers in sql package.
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 
 	// Needed for posgres migrations.
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -26,6 +26,8 @@ type Adapter struct {
 }
 
 // NewAdapter creates new db adapter.
+// Migrations from `migrationsDir` are applied before returning the adapter.
+// If `migrationsDir` is empty, migrations are skipped.
 func NewAdapter(
 	hostport string,
 	dbname string,
@@ -36,18 +38,21 @@ func NewAdapter(
 ) (*Adapter, error) {
 	dbURL := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, pass, hostport, dbname)
 
-	m, err := migrate.New("file://"+migrationsDir, dbURL)
-	if err != nil {
-		return nil, fmt.Errorf("initiating migrations: %w", err)
-	}
-	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return nil, fmt.Errorf("migrating db: %w", err)
+	if migrationsDir != "" {
+		m, err := migrate.New("file://"+migrationsDir, dbURL)
+		if err != nil {
+			return nil, fmt.Errorf("initiating migrations: %w", err)
+		}
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return nil, fmt.Errorf("migrating db: %w", err)
+		}
 	}
 
-	db, err := sqlx.Open("postgres", dbURL)
+	connector, err := pq.NewConnector(dbURL)
 	if err != nil {
 		return nil, fmt.Errorf("opening postgres db: %w", err)
 	}
+	db := sqlx.NewDb(sql.OpenDB(tracedConnector{Connector: connector, tracer: tracer}), "postgres")
 
 	if err = db.Ping(); err != nil {
 		return nil, fmt.Errorf("connection to postgres db failed: %w", err)
@@ -88,3 +93,37 @@ func (a *Adapter) Customers() *CustomersRepository {
 		log: a.log.WithField("repository", "db.customers"),
 	}
 }
+
+// PromoCodes returns promo codes repository.
+func (a *Adapter) PromoCodes() *PromoCodesRepository {
+	return &PromoCodesRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.promocodes"),
+	}
+}
+
+// Stations returns stations repository.
+func (a *Adapter) Stations() *StationsRepository {
+	return &StationsRepository{
+		db:  a.db,
+		log: a.log.WithField("repository", "db.stations"),
+	}
+}
+
+// Maintenance returns bike maintenance windows repository.
+func (a *Adapter) Maintenance() *MaintenanceRepository {
+	return &MaintenanceRepository{
+		parent: a,
+		db:     a.db,
+		log:    a.log.WithField("repository", "db.maintenance"),
+	}
+}
+
+// Waitlist returns waitlist repository.
+func (a *Adapter) Waitlist() *WaitlistRepository {
+	return &WaitlistRepository{
+		parent: a,
+		db:     a.db,
+		log:    a.log.WithField("repository", "db.waitlist"),
+	}
+}
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// newTestAdapter connects to postgres db used for integration tests, and applies migrations.
+// The test is skipped if TEST_POSTGRES_HOSTPORT is not set.
+func newTestAdapter(t *testing.T) *Adapter {
+	t.Helper()
+
+	hostport := os.Getenv("TEST_POSTGRES_HOSTPORT")
+	if hostport == "" {
+		t.Skip("TEST_POSTGRES_HOSTPORT not set, skipping db integration test")
+	}
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	a, err := NewAdapter(
+		hostport,
+		envOrDefault("TEST_POSTGRES_DB", "testdb"),
+		envOrDefault("TEST_POSTGRES_USER", "postgres"),
+		envOrDefault("TEST_POSTGRES_PASS", "password"),
+		"../../../configs/postgresql",
+		log,
+	)
+	if err != nil {
+		t.Fatalf("creating db adapter: %v", err)
+	}
+	t.Cleanup(a.Close)
+	return a
+}
+
+func envOrDefault(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -10,24 +10,42 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/bikes"
 	"github.com/sirupsen/logrus"
 )
 
+var errBikeSerialNumberExists = app.NewConflictError("bike with this serial number already exists")
+
 // BikesRepository manages bikes in db.
 type BikesRepository struct {
 	db  *sqlx.DB
 	log logrus.FieldLogger
 }
 
-// List returns list of all bikes from db sorted by name ascending.
-func (r *BikesRepository) List(ctx context.Context) ([]bikerental.Bike, error) {
-	var bikes []bikeModel
-	if err := r.db.SelectContext(ctx, &bikes, "select * from bikes order by model_name asc"); err != nil {
+// List returns list of bikes from db sorted by name and id ascending.
+// Bikes without model name are sorted as if the name was empty.
+func (r *BikesRepository) List(ctx context.Context, query bikes.ListBikesQuery) ([]bikerental.Bike, error) {
+	sqlq := sqlBuilder.Select("*").
+		From("bikes").
+		OrderBy("coalesce(model_name, '') asc", "id asc")
+	if query.After.ID != "" {
+		sqlq = sqlq.Where("(coalesce(model_name, ''), id) > (?, ?)", query.After.ModelName, query.After.ID)
+	}
+	if query.Limit > 0 {
+		sqlq = sqlq.Limit(uint64(query.Limit))
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var bs []bikeModel
+	if err := r.db.SelectContext(ctx, &bs, q, args...); err != nil {
 		return nil, fmt.Errorf("querying postgres: %w", err)
 	}
 
-	result := make([]bikerental.Bike, 0, len(bikes))
-	for _, b := range bikes {
+	result := make([]bikerental.Bike, 0, len(bs))
+	for _, b := range bs {
 		result = append(result, b.ToAppBike())
 	}
 	return result, nil
@@ -48,43 +66,82 @@ func (r *BikesRepository) Get(ctx context.Context, id string) (*bikerental.Bike,
 }
 
 // Create creates new bike in db.
+// Returns app.ValidationError if bike station doesn't exist, and app.ConflictError if the station is full.
 func (r *BikesRepository) Create(ctx context.Context, b bikerental.Bike) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if err := r.checkStationInTx(ctx, tx, b.ID, b.StationID); err != nil {
+		return err
+	}
+
 	sqlq := sqlBuilder.Insert("bikes").
-		Columns("id", "model_name", "weight", "price_per_h").
+		Columns("id", "model_name", "weight", "price_per_h", "serial_number", "station_id", "status").
 		Values(
 			squirrel.Expr(":id"),
 			squirrel.Expr(":model_name"),
 			squirrel.Expr(":weight"),
 			squirrel.Expr(":price_per_h"),
+			squirrel.Expr(":serial_number"),
+			squirrel.Expr(":station_id"),
+			squirrel.Expr(":status"),
 		)
 	q, _, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	if _, err = r.db.NamedExecContext(ctx, q, newBikeModel(b)); err != nil {
+	if _, err = tx.NamedExecContext(ctx, q, newBikeModel(b)); err != nil {
+		if isPgError(err, pgErrUniqueViolation) {
+			return errBikeSerialNumberExists
+		}
 		return fmt.Errorf("inserting bike row into postgres: %w", err)
 	}
 
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
 	app.AugmentLogFromCtx(ctx, r.log).WithField("id", b.ID).Info("bike created in db")
 
 	return nil
 }
 
 // Update updates a bike in db by id. If bike is not in db, returns app.ErrNotFound error.
+// Returns app.ValidationError if bike station doesn't exist, and app.ConflictError if the station is full.
 func (r *BikesRepository) Update(ctx context.Context, id string, b bikerental.Bike) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if err := r.checkStationInTx(ctx, tx, id, b.StationID); err != nil {
+		return err
+	}
+
+	m := newBikeModel(b)
 	sqlq := sqlBuilder.Update("bikes").
-		Set("model_name", b.ModelName).
-		Set("weight", b.Weight).
-		Set("price_per_h", b.PricePerHour).
+		Set("model_name", m.ModelName).
+		Set("weight", m.Weight).
+		Set("price_per_h", m.PricePerHour).
+		Set("serial_number", m.SerialNumber).
+		Set("station_id", m.StationID).
+		Set("status", m.Status).
 		Where(squirrel.Eq{"id": id})
 	q, args, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	res, err := r.db.ExecContext(ctx, q, args...)
+	res, err := tx.ExecContext(ctx, q, args...)
 	if err != nil {
+		if isPgError(err, pgErrUniqueViolation) {
+			return errBikeSerialNumberExists
+		}
 		return fmt.Errorf("updating bike row in postgres: %w", err)
 	}
 	rows, _ := res.RowsAffected()
@@ -92,11 +149,40 @@ func (r *BikesRepository) Update(ctx context.Context, id string, b bikerental.Bi
 		return app.ErrNotFound
 	}
 
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
 	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("bike updated in db")
 
 	return nil
 }
 
+// checkStationInTx checks if bike can be assigned to the station.
+// Station row is locked until the end of the transaction, so concurrent assignments can't exceed its capacity.
+func (r *BikesRepository) checkStationInTx(ctx context.Context, tx *sqlx.Tx, bikeID, stationID string) error {
+	if stationID == "" {
+		return nil
+	}
+
+	var capacity int
+	if err := tx.GetContext(ctx, &capacity, `select capacity from stations where id=$1 for update`, stationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return app.NewValidationError("station doesn't exist")
+		}
+		return fmt.Errorf("querying postgres: %w", err)
+	}
+
+	var assigned int
+	if err := tx.GetContext(ctx, &assigned, `select count(*) from bikes where station_id=$1 and id<>$2`, stationID, bikeID); err != nil {
+		return fmt.Errorf("counting station bikes in postgres: %w", err)
+	}
+	if assigned >= capacity {
+		return app.NewConflictError("station is full")
+	}
+	return nil
+}
+
 // Delete deletes a bike from db by id. If bike is not in db, returns app.ErrNotFound error.
 func (r *BikesRepository) Delete(ctx context.Context, id string) error {
 	res, err := r.db.ExecContext(ctx, `delete from bikes where id=$1`, id)
@@ -114,17 +200,35 @@ func (r *BikesRepository) Delete(ctx context.Context, id string) error {
 }
 
 type bikeModel struct {
-	ID           string  `db:"id"`
-	ModelName    string  `db:"model_name"`
-	Weight       float64 `db:"weight"`
-	PricePerHour int     `db:"price_per_h"`
+	ID           string         `db:"id"`
+	ModelName    string         `db:"model_name"`
+	Weight       float64        `db:"weight"`
+	PricePerHour int            `db:"price_per_h"`
+	SerialNumber string         `db:"serial_number"`
+	StationID    sql.NullString `db:"station_id"`
+	Status       string         `db:"status"`
 }
 
 func newBikeModel(ab bikerental.Bike) bikeModel {
-	// In this example bike is really simple and can be exactly the same as domain bike type.
-	return bikeModel(ab)
+	return bikeModel{
+		ID:           ab.ID,
+		ModelName:    ab.ModelName,
+		Weight:       ab.Weight,
+		PricePerHour: ab.PricePerHour,
+		SerialNumber: ab.SerialNumber,
+		StationID:    sql.NullString{String: ab.StationID, Valid: ab.StationID != ""},
+		Status:       string(ab.Status),
+	}
 }
 
 func (b *bikeModel) ToAppBike() bikerental.Bike {
-	return bikerental.Bike(*b)
+	return bikerental.Bike{
+		ID:           b.ID,
+		ModelName:    b.ModelName,
+		Weight:       b.Weight,
+		PricePerHour: b.PricePerHour,
+		SerialNumber: b.SerialNumber,
+		StationID:    b.StationID.String,
+		Status:       bikerental.BikeStatus(b.Status),
+	}
 }
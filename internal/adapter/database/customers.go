@@ -8,17 +8,60 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/customers"
 	"github.com/sirupsen/logrus"
 )
 
+var errCustomerEmailExists = app.NewConflictError("customer with this email already exists")
+
+// errCustomerCreatedConcurrently is returned when customer resolved by email was created by a concurrent transaction.
+// It's not a conflict error, so it isn't mistaken for unavailable bike.
+var errCustomerCreatedConcurrently = errors.New("customer with this email was created concurrently")
+
 // CustomersRepository manages customers in db.
 type CustomersRepository struct {
 	db  *sqlx.DB
 	log logrus.FieldLogger
 }
 
+// List returns list of customers matching query criteria, sorted by id ascending.
+// Email is matched case-insensitively.
+func (r *CustomersRepository) List(ctx context.Context, query customers.ListCustomersQuery) ([]bikerental.Customer, error) {
+	sqlq := sqlBuilder.Select("*").
+		From("customers").
+		OrderBy("id asc")
+	if query.Email != "" {
+		sqlq = sqlq.Where("lower(email) = lower(?)", query.Email)
+	}
+	if query.Type != bikerental.CustomerTypeUnknown {
+		sqlq = sqlq.Where(squirrel.Eq{"type": newCustomerTypeModel(query.Type)})
+	}
+	if query.After.ID != "" {
+		sqlq = sqlq.Where(squirrel.Gt{"id": query.After.ID})
+	}
+	if query.Limit > 0 {
+		sqlq = sqlq.Limit(uint64(query.Limit))
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var cs []customerModel
+	if err := r.db.SelectContext(ctx, &cs, q, args...); err != nil {
+		return nil, fmt.Errorf("querying for customers in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.Customer, 0, len(cs))
+	for _, c := range cs {
+		result = append(result, c.ToAppCustomer())
+	}
+	return result, nil
+}
+
 // GetInTx returns a customer by id using existing transaction.
 // If customer doesn't exists, returns app.ErrNotFound error.
 func (r *CustomersRepository) GetInTx(ctx context.Context, tx *sqlx.Tx, id string) (*bikerental.Customer, error) {
@@ -34,6 +77,39 @@ func (r *CustomersRepository) GetInTx(ctx context.Context, tx *sqlx.Tx, id strin
 	return &result, nil
 }
 
+// GetByEmailInTx returns a customer by email using existing transaction.
+// Emails are compared case-insensitively.
+// If customer doesn't exists, returns app.ErrNotFound error.
+func (r *CustomersRepository) GetByEmailInTx(ctx context.Context, tx *sqlx.Tx, email string) (*bikerental.Customer, error) {
+	if email == "" {
+		return nil, app.ErrNotFound
+	}
+
+	var m customerModel
+	if err := tx.GetContext(ctx, &m, `select * from customers where lower(email) = lower($1) and email <> ''`, email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppCustomer()
+	return &result, nil
+}
+
+// GetByEmail returns a customer by email. If it doesn't exists, returns app.ErrNotFound error.
+func (r *CustomersRepository) GetByEmail(ctx context.Context, email string) (*bikerental.Customer, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Commit()
+	}()
+
+	return r.GetByEmailInTx(ctx, tx, email)
+}
+
 // Get returns a customer by id. If it doesn't exists, returns app.ErrNotFound error.
 func (r *CustomersRepository) Get(ctx context.Context, id string) (*bikerental.Customer, error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
@@ -48,6 +124,7 @@ func (r *CustomersRepository) Get(ctx context.Context, id string) (*bikerental.C
 }
 
 // CreateInTx creates new customer in db using existing db transaction.
+// Returns app.ConflictError if customer with the same email already exists.
 func (r *CustomersRepository) CreateInTx(ctx context.Context, tx *sqlx.Tx, c bikerental.Customer) error {
 	sqlq := sqlBuilder.Insert("customers").
 		Columns("id", "type", "first_name", "surname", "email").
@@ -64,6 +141,9 @@ func (r *CustomersRepository) CreateInTx(ctx context.Context, tx *sqlx.Tx, c bik
 	}
 
 	if _, err = tx.NamedExecContext(ctx, q, newCustmerModel(c)); err != nil {
+		if isPgError(err, pgErrUniqueViolation) {
+			return errCustomerEmailExists
+		}
 		return fmt.Errorf("inserting customer row into postgres: %w", err)
 	}
 
@@ -85,10 +165,45 @@ func (r *CustomersRepository) Create(ctx context.Context, c bikerental.Customer)
 	return r.CreateInTx(ctx, tx, c)
 }
 
+// Update updates a customer in db by id. If customer is not in db, returns app.ErrNotFound error.
+func (r *CustomersRepository) Update(ctx context.Context, id string, c bikerental.Customer) error {
+	m := newCustmerModel(c)
+	sqlq := sqlBuilder.Update("customers").
+		Set("type", m.Type).
+		Set("first_name", m.FirstName).
+		Set("surname", m.Surname).
+		Set("email", m.Email).
+		Where(squirrel.Eq{"id": id})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		if isPgError(err, pgErrUniqueViolation) {
+			return errCustomerEmailExists
+		}
+		return fmt.Errorf("updating customer row in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("customer updated in db")
+
+	return nil
+}
+
 // Delete removes customer from db.
+// Returns app.ConflictError if customer has reservations or waitlist entries.
 func (r *CustomersRepository) Delete(ctx context.Context, id string) error {
 	res, err := r.db.ExecContext(ctx, `delete from customers where id=$1`, id)
 	if err != nil {
+		if isPgError(err, pgErrForeignKeyViolation) {
+			return customerReferencedError(err)
+		}
 		return fmt.Errorf("deleting customer row from postgres: %w", err)
 	}
 	rows, _ := res.RowsAffected()
@@ -101,6 +216,102 @@ func (r *CustomersRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// customerReferencedError returns conflict error describing rows which prevent deleting a customer.
+// Foreign key violation error reports the referencing table.
+func customerReferencedError(err error) error {
+	var pgErr *pq.Error
+	if errors.As(err, &pgErr) {
+		switch pgErr.Table {
+		case "reservations":
+			return app.NewConflictError("customer has reservations")
+		case "waitlist_entries":
+			return app.NewConflictError("customer has waitlist entries")
+		}
+	}
+	return app.NewConflictError("customer is referenced by other data")
+}
+
+// customerReferences are tables with rows referencing customers, moved to the kept customer when merging duplicates.
+var customerReferences = []struct {
+	table string
+	desc  string
+}{
+	{table: "reservations", desc: "reservations"},
+	{table: "promo_code_redemptions", desc: "promo code redemptions"},
+	{table: "waitlist_entries", desc: "waitlist entries"},
+	{table: "notifications", desc: "notifications"},
+}
+
+// MergeDuplicates merges customers with the same email (compared case-insensitively) into one customer.
+// Customer with the most reservations is kept, rows referencing other customers are moved to it,
+// and other customers are deleted.
+// Tables which don't exist in db schema yet are skipped, so duplicates can be merged on any schema version
+// that has the customers email column, before applying migration that adds unique email index.
+// Returns number of deleted customers.
+func (r *CustomersRepository) MergeDuplicates(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelSerializable,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	_, err = tx.ExecContext(ctx, `
+		create temporary table customer_merges on commit drop as
+		select duplicate_id, keep_id from (
+			select
+				c.id as duplicate_id,
+				first_value(c.id) over (
+					partition by lower(c.email)
+					order by coalesce(r.n, 0) desc, c.id asc
+				) as keep_id
+			from customers c
+			left join (
+				select customer_id, count(*) as n from reservations group by customer_id
+			) r on r.customer_id = c.id
+			where c.email <> ''
+		) m
+		where duplicate_id <> keep_id`,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("finding duplicated customers in postgres: %w", err)
+	}
+
+	for _, t := range customerReferences {
+		var exists bool
+		if err := tx.GetContext(ctx, &exists, "select to_regclass($1) is not null", t.table); err != nil {
+			return 0, fmt.Errorf("checking if %s table exists in postgres: %w", t.table, err)
+		}
+		if !exists {
+			// Table is added by a later migration, so there is nothing to move.
+			continue
+		}
+
+		q := fmt.Sprintf(
+			"update %s t set customer_id = m.keep_id from customer_merges m where t.customer_id = m.duplicate_id",
+			t.table,
+		)
+		if _, err := tx.ExecContext(ctx, q); err != nil {
+			return 0, fmt.Errorf("moving %s in postgres: %w", t.desc, err)
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, `delete from customers c using customer_merges m where c.id = m.duplicate_id`)
+	if err != nil {
+		return 0, fmt.Errorf("deleting duplicated customer rows from postgres: %w", err)
+	}
+	deleted, _ := res.RowsAffected()
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return 0, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("deleted", deleted).Info("duplicated customers merged in db")
+
+	return int(deleted), nil
+}
+
 type customerModel struct {
 	ID        string `db:"id"`
 	Type      string `db:"type"`
@@ -110,20 +321,23 @@ type customerModel struct {
 }
 
 func newCustmerModel(ac bikerental.Customer) customerModel {
-	c := customerModel{
+	return customerModel{
 		ID:        ac.ID,
+		Type:      newCustomerTypeModel(ac.Type),
 		FirstName: ac.FirstName,
 		Surname:   ac.Surname,
 		Email:     ac.Email,
 	}
-	switch ac.Type {
+}
+
+func newCustomerTypeModel(t bikerental.CustomerType) string {
+	switch t {
 	case bikerental.CustomerTypeBusiness:
-		c.Type = customerTypeBusiness
+		return customerTypeBusiness
 	case bikerental.CustomerTypeIndividual:
-		c.Type = customerTypeIndividual
+		return customerTypeIndividual
 	}
-
-	return c
+	return ""
 }
 
 func (m *customerModel) ToAppCustomer() bikerental.Customer {
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func TestCustomerReferencedError(t *testing.T) {
+	tests := []struct {
+		table   string
+		wantMsg string
+	}{
+		{table: "reservations", wantMsg: "customer has reservations"},
+		{table: "waitlist_entries", wantMsg: "customer has waitlist entries"},
+		{table: "other", wantMsg: "customer is referenced by other data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table, func(t *testing.T) {
+			err := fmt.Errorf("exec: %w", &pq.Error{Code: pgErrForeignKeyViolation, Table: tt.table})
+
+			got := customerReferencedError(err)
+			if !app.IsConflictError(got) {
+				t.Fatalf("customerReferencedError() = %v, want conflict error", got)
+			}
+			if got.Error() != tt.wantMsg {
+				t.Fatalf("customerReferencedError() message = %q, want %q", got.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
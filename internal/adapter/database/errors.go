@@ -0,0 +1,36 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pgErrForeignKeyViolation pq.ErrorCode = "23503"
+	pgErrUniqueViolation     pq.ErrorCode = "23505"
+	pgErrExclusionViolation  pq.ErrorCode = "23P01"
+
+	pgErrSerializationFailure pq.ErrorCode = "40001"
+	pgErrDeadlockDetected     pq.ErrorCode = "40P01"
+)
+
+// errConcurrentUpdate is returned when repeatable read transaction fails,
+// because rows it used were changed or locked by a concurrent transaction.
+var errConcurrentUpdate = app.NewConflictError("data was changed by a concurrent transaction")
+
+func isPgError(err error, code pq.ErrorCode) bool {
+	var pgErr *pq.Error
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
+
+// mapSerializationFailure returns errConcurrentUpdate if err is a serialization failure or a deadlock, and err otherwise.
+// Deadlocks are possible when reservations of many bikes lock them in different order.
+func mapSerializationFailure(err error) error {
+	if isPgError(err, pgErrSerializationFailure) || isPgError(err, pgErrDeadlockDetected) {
+		return errConcurrentUpdate
+	}
+	return err
+}
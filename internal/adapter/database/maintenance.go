@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental/reservation"
+	"github.com/sirupsen/logrus"
+)
+
+// MaintenanceRepository manages bike maintenance windows in db.
+type MaintenanceRepository struct {
+	parent *Adapter
+	db     *sqlx.DB
+	log    logrus.FieldLogger
+}
+
+// List returns maintenance windows of a bike overlapping with given time range, sorted by start time.
+func (r *MaintenanceRepository) List(ctx context.Context, bikeID string, startTime, endTime time.Time) ([]bikerental.MaintenanceWindow, error) {
+	sqlq := sqlBuilder.Select("*").
+		From("maintenance_windows").
+		Where(squirrel.Eq{"bike_id": bikeID}).
+		OrderBy("start_time asc", "id asc")
+	if !startTime.IsZero() {
+		sqlq = sqlq.Where(squirrel.Gt{"end_time": startTime})
+	}
+	if !endTime.IsZero() {
+		sqlq = sqlq.Where(squirrel.Lt{"start_time": endTime})
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var ms []maintenanceWindowModel
+	if err := r.db.SelectContext(ctx, &ms, q, args...); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.MaintenanceWindow, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, bikerental.MaintenanceWindow(m))
+	}
+	return result, nil
+}
+
+// Get returns a maintenance window by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *MaintenanceRepository) Get(ctx context.Context, id string) (*bikerental.MaintenanceWindow, error) {
+	var m maintenanceWindowModel
+	if err := r.db.GetContext(ctx, &m, "select * from maintenance_windows where id=$1", id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := bikerental.MaintenanceWindow(m)
+	return &result, nil
+}
+
+// Create creates maintenance window in db, if there are no approved, active or held bike reservations overlapping with it.
+// Otherwise the window is not created, and overlapping reservations are returned.
+func (r *MaintenanceRepository) Create(ctx context.Context, w bikerental.MaintenanceWindow) ([]bikerental.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	// Lock the bike, so maintenance windows and reservations of one bike are scheduled one at a time.
+	// The row is updated, not only locked, so reservation transactions which started before this one
+	// fail with serialization error instead of missing the window in their snapshot.
+	res, err := tx.ExecContext(ctx, "update bikes set id = id where id=$1", w.BikeID)
+	if err != nil {
+		return nil, fmt.Errorf("locking bike row in postgres: %w", err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, app.ErrNotFound
+	}
+
+	// Expired holds don't block the window, other pending reservations do.
+	if _, err := r.parent.Reservations().expireHolds(ctx, tx, w.BikeID, time.Now()); err != nil {
+		return nil, err
+	}
+	conflicts, err := r.parent.Reservations().list(ctx, tx, reservation.ListReservationsQuery{
+		BikeID:    w.BikeID,
+		StartTime: w.StartTime,
+		EndTime:   w.EndTime,
+		Statuses: []bikerental.ReservationStatus{
+			bikerental.ReservationStatusApproved,
+			bikerental.ReservationStatusActive,
+			bikerental.ReservationStatusPending,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying for conflicting reservations: %w", err)
+	}
+	if len(conflicts) > 0 {
+		return conflicts, nil
+	}
+
+	sqlq := sqlBuilder.Insert("maintenance_windows").
+		Columns("id", "bike_id", "start_time", "end_time", "reason").
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":bike_id"),
+			squirrel.Expr(":start_time"),
+			squirrel.Expr(":end_time"),
+			squirrel.Expr(":reason"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+	if _, err := tx.NamedExecContext(ctx, q, maintenanceWindowModel(w)); err != nil {
+		return nil, fmt.Errorf("inserting maintenance window row into postgres: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", w.ID).
+		WithField("bikeId", w.BikeID).
+		Info("maintenance window created in db")
+
+	return nil, nil
+}
+
+// Delete deletes maintenance window by id, and offers freed time range to waitlist in the same transaction.
+// Returns promoted reservations, and app.ErrNotFound if window doesn't exist.
+func (r *MaintenanceRepository) Delete(ctx context.Context, id string) ([]bikerental.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	var freed freedSlot
+	err = tx.GetContext(ctx, &freed, `delete from maintenance_windows where id=$1 returning bike_id, start_time, end_time`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, app.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("deleting maintenance window row from postgres: %w", err)
+	}
+
+	promoted, err := r.parent.Waitlist().promoteFreedInTx(ctx, tx, []freedSlot{freed})
+	if err != nil {
+		return nil, fmt.Errorf("promoting waitlist entries: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("maintenance window deleted from db")
+
+	return promoted, nil
+}
+
+func (r *MaintenanceRepository) hasOverlappingInTx(ctx context.Context, tx *sqlx.Tx, bikeID string, startTime, endTime time.Time) (bool, error) {
+	var exists bool
+	err := tx.GetContext(
+		ctx,
+		&exists,
+		"select exists(select 1 from maintenance_windows where bike_id=$1 and end_time > $2 and start_time < $3)",
+		bikeID, startTime, endTime,
+	)
+	if err != nil {
+		return false, fmt.Errorf("querying for overlapping maintenance windows in postgresql: %w", err)
+	}
+	return exists, nil
+}
+
+type maintenanceWindowModel struct {
+	ID        string    `db:"id"`
+	BikeID    string    `db:"bike_id"`
+	StartTime time.Time `db:"start_time"`
+	EndTime   time.Time `db:"end_time"`
+	Reason    string    `db:"reason"`
+}
@@ -0,0 +1,218 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+const promoCodesSelect = `select p.* from promo_codes p`
+
+// PromoCodesRepository manages promo codes in db.
+type PromoCodesRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns list of all promo codes from db sorted by code ascending.
+func (r *PromoCodesRepository) List(ctx context.Context) ([]bikerental.PromoCode, error) {
+	var pcs []promoCodeModel
+	if err := r.db.SelectContext(ctx, &pcs, promoCodesSelect+" order by p.code asc"); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.PromoCode, 0, len(pcs))
+	for _, pc := range pcs {
+		result = append(result, pc.ToAppPromoCode())
+	}
+	return result, nil
+}
+
+// Get returns a promo code. If it doesn't exists, returns app.ErrNotFound error.
+func (r *PromoCodesRepository) Get(ctx context.Context, code string) (*bikerental.PromoCode, error) {
+	var pc promoCodeModel
+	if err := r.db.GetContext(ctx, &pc, promoCodesSelect+" where p.code=$1", code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := pc.ToAppPromoCode()
+	return &result, nil
+}
+
+// Create creates new promo code in db.
+// Returns app.ConflictError if code already exists.
+func (r *PromoCodesRepository) Create(ctx context.Context, pc bikerental.PromoCode) error {
+	sqlq := sqlBuilder.Insert("promo_codes").
+		Columns(
+			"code", "discount_percent", "discount_amount", "valid_from", "valid_to",
+			"max_redemptions", "max_redemptions_per_customer", "stackable", "disabled",
+		).
+		Values(
+			squirrel.Expr(":code"),
+			squirrel.Expr(":discount_percent"),
+			squirrel.Expr(":discount_amount"),
+			squirrel.Expr(":valid_from"),
+			squirrel.Expr(":valid_to"),
+			squirrel.Expr(":max_redemptions"),
+			squirrel.Expr(":max_redemptions_per_customer"),
+			squirrel.Expr(":stackable"),
+			squirrel.Expr(":disabled"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	if _, err = r.db.NamedExecContext(ctx, q, newPromoCodeModel(pc)); err != nil {
+		if isPgError(err, pgErrUniqueViolation) {
+			return app.NewConflictError("promo code already exists")
+		}
+		return fmt.Errorf("inserting promo code row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("code", pc.Code).Info("promo code created in db")
+
+	return nil
+}
+
+// Disable marks promo code as disabled. If code is not in db, returns app.ErrNotFound error.
+func (r *PromoCodesRepository) Disable(ctx context.Context, code string) error {
+	sqlq := sqlBuilder.Update("promo_codes").
+		Set("disabled", true).
+		Where(squirrel.Eq{"code": code})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("updating promo code row in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("code", code).Info("promo code disabled in db")
+
+	return nil
+}
+
+// RedeemInTx records promo code redemption for a reservation using existing db transaction.
+// Redemptions counter is incremented only if it's below the limit, so concurrent redemptions can't exceed it.
+// Promo code row is locked until the end of the transaction. In repeatable read transactions,
+// a concurrent redemption of the same code fails with serialization error instead of reading stale counts.
+// Returns bikerental.ErrPromoCodeNotRedeemable if the code can't be used at time `t` or its limits were reached.
+func (r *PromoCodesRepository) RedeemInTx(ctx context.Context, tx *sqlx.Tx, code, reservationID, customerID string, t time.Time) error {
+	var pc promoCodeModel
+	err := tx.GetContext(ctx, &pc, `select p.* from promo_codes p where p.code=$1 for update`, code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("promo code doesn't exist: %w", bikerental.ErrPromoCodeNotRedeemable)
+		}
+		return fmt.Errorf("querying postgres: %w", err)
+	}
+	if err := pc.ToAppPromoCode().CheckActive(t); err != nil {
+		return fmt.Errorf("%s: %w", err.Error(), bikerental.ErrPromoCodeNotRedeemable)
+	}
+
+	var perCustomer int
+	err = tx.GetContext(
+		ctx,
+		&perCustomer,
+		`select count(*) from promo_code_redemptions where code = $1 and customer_id = $2`,
+		code, customerID,
+	)
+	if err != nil {
+		return fmt.Errorf("counting promo code redemptions in postgres: %w", err)
+	}
+	if pc.MaxRedemptionsPerCustomer > 0 && perCustomer >= pc.MaxRedemptionsPerCustomer {
+		return fmt.Errorf("promo code redemption limit per customer reached: %w", bikerental.ErrPromoCodeNotRedeemable)
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		`update promo_codes set redemptions = redemptions + 1 where code = $1 and (max_redemptions = 0 or redemptions < max_redemptions)`,
+		code,
+	)
+	if err != nil {
+		return fmt.Errorf("updating promo code redemptions in postgres: %w", err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return fmt.Errorf("promo code redemption limit reached: %w", bikerental.ErrPromoCodeNotRedeemable)
+	}
+
+	sqlq := sqlBuilder.Insert("promo_code_redemptions").
+		Columns("code", "reservation_id", "customer_id").
+		Values(code, reservationID, customerID)
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, q, args...); err != nil {
+		return fmt.Errorf("inserting promo code redemption row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("code", code).
+		WithField("reservationId", reservationID).
+		Info("promo code redeemed in db")
+
+	return nil
+}
+
+type promoCodeModel struct {
+	Code                      string    `db:"code"`
+	DiscountPercent           float64   `db:"discount_percent"`
+	DiscountAmount            int       `db:"discount_amount"`
+	ValidFrom                 time.Time `db:"valid_from"`
+	ValidTo                   time.Time `db:"valid_to"`
+	MaxRedemptions            int       `db:"max_redemptions"`
+	MaxRedemptionsPerCustomer int       `db:"max_redemptions_per_customer"`
+	Stackable                 bool      `db:"stackable"`
+	Disabled                  bool      `db:"disabled"`
+
+	// Kept up to date with promo_code_redemptions, see RedeemInTx.
+	Redemptions int `db:"redemptions"`
+}
+
+func newPromoCodeModel(pc bikerental.PromoCode) promoCodeModel {
+	return promoCodeModel{
+		Code:                      pc.Code,
+		DiscountPercent:           pc.Percent,
+		DiscountAmount:            pc.Amount,
+		ValidFrom:                 pc.ValidFrom,
+		ValidTo:                   pc.ValidTo,
+		MaxRedemptions:            pc.MaxRedemptions,
+		MaxRedemptionsPerCustomer: pc.MaxRedemptionsPerCustomer,
+		Stackable:                 pc.Stackable,
+		Disabled:                  pc.Disabled,
+	}
+}
+
+func (m *promoCodeModel) ToAppPromoCode() bikerental.PromoCode {
+	return bikerental.PromoCode{
+		Code:                      m.Code,
+		Percent:                   m.DiscountPercent,
+		Amount:                    m.DiscountAmount,
+		ValidFrom:                 m.ValidFrom,
+		ValidTo:                   m.ValidTo,
+		MaxRedemptions:            m.MaxRedemptions,
+		MaxRedemptionsPerCustomer: m.MaxRedemptionsPerCustomer,
+		Stackable:                 m.Stackable,
+		Disabled:                  m.Disabled,
+		Redemptions:               m.Redemptions,
+	}
+}
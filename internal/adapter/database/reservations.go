@@ -22,9 +22,13 @@ const (
 	customerTypeIndividual = "individual"
 )
 
-const (
-	defaultReservationsLimit = 10
-)
+// releasedStatuses are statuses of reservations which don't block the bike.
+// They have to match statuses ignored by reservations_bike_no_overlap constraint.
+var releasedStatuses = []string{
+	string(bikerental.ReservationStatusCanceled),
+	string(bikerental.ReservationStatusNoShow),
+	string(bikerental.ReservationStatusExpired),
+}
 
 // ReservationsRepository manages reservation data in db.
 type ReservationsRepository struct {
@@ -35,6 +39,10 @@ type ReservationsRepository struct {
 
 // List returns list of reservations matching request criteria.
 func (r *ReservationsRepository) List(ctx context.Context, query reservation.ListReservationsQuery) ([]bikerental.Reservation, error) {
+	return r.list(ctx, r.db, query)
+}
+
+func (r *ReservationsRepository) list(ctx context.Context, db sqlx.QueryerContext, query reservation.ListReservationsQuery) ([]bikerental.Reservation, error) {
 	sqlq := sqlBuilder.Select(
 		"r.*",
 		"c.first_name", "c.surname", "c.email", "c.type",
@@ -42,23 +50,32 @@ func (r *ReservationsRepository) List(ctx context.Context, query reservation.Lis
 	).
 		From("reservations r").
 		Join("customers c on r.customer_id = c.id").
-		Join("bikes b on r.bike_id = b.id")
+		Join("bikes b on r.bike_id = b.id").
+		OrderBy("r.start_time asc", "r.id asc")
 	if query.BikeID != "" {
 		sqlq = sqlq.Where(squirrel.Eq{"r.bike_id": query.BikeID})
 	}
+	if query.SeriesID != "" {
+		sqlq = sqlq.Where(squirrel.Eq{"r.series_id": query.SeriesID})
+	}
 	if !query.StartTime.IsZero() {
 		sqlq = sqlq.Where(squirrel.Gt{"r.end_time": query.StartTime})
 	}
 	if !query.EndTime.IsZero() {
 		sqlq = sqlq.Where(squirrel.Lt{"r.start_time": query.EndTime})
 	}
-	if query.Status != bikerental.ReservationStatusEmpty {
-		sqlq = sqlq.Where(squirrel.Eq{"r.status": query.Status})
+	if len(query.Statuses) > 0 {
+		statuses := make([]string, 0, len(query.Statuses))
+		for _, st := range query.Statuses {
+			statuses = append(statuses, string(st))
+		}
+		sqlq = sqlq.Where(squirrel.Eq{"r.status": statuses})
+	}
+	if query.After.ID != "" {
+		sqlq = sqlq.Where("(r.start_time, r.id) > (?, ?)", query.After.StartTime, query.After.ID)
 	}
 	if query.Limit > 0 {
 		sqlq = sqlq.Limit(uint64(query.Limit))
-	} else {
-		sqlq = sqlq.Limit(defaultReservationsLimit)
 	}
 	q, args, err := sqlq.ToSql()
 	if err != nil {
@@ -66,7 +83,7 @@ func (r *ReservationsRepository) List(ctx context.Context, query reservation.Lis
 	}
 
 	var rs []reservationModel
-	if err := r.db.SelectContext(ctx, &rs, q, args...); err != nil {
+	if err := sqlx.SelectContext(ctx, db, &rs, q, args...); err != nil {
 		return nil, fmt.Errorf("querying for reservations in postgresql: %w", err)
 	}
 
@@ -77,6 +94,79 @@ func (r *ReservationsRepository) List(ctx context.Context, query reservation.Lis
 	return result, nil
 }
 
+// SearchAvailableBikes returns bikes in service, without reservations blocking them in query time range.
+// Bikes are sorted by distance and price.
+func (r *ReservationsRepository) SearchAvailableBikes(ctx context.Context, query reservation.AvailableBikesQuery) ([]bikerental.AvailableBike, error) {
+	// Anti-join with reservations and maintenance windows overlapping query time range.
+	// Statuses have to match ones ignored in checkAvailability. Expired holds don't block bikes.
+	subq := squirrel.Select("b.*").
+		From("bikes b").
+		LeftJoin(
+			"reservations r on r.bike_id = b.id and r.end_time > ? and r.start_time < ? and r.status not in (?, ?, ?)"+
+				" and not (r.status = ? and r.hold_expires_at <= ?)",
+			query.StartTime, query.EndTime, releasedStatuses[0], releasedStatuses[1], releasedStatuses[2],
+			bikerental.ReservationStatusPending, time.Now(),
+		).
+		LeftJoin(
+			"maintenance_windows m on m.bike_id = b.id and m.end_time > ? and m.start_time < ?",
+			query.StartTime, query.EndTime,
+		).
+		Where(squirrel.Eq{"r.id": nil}).
+		Where(squirrel.Eq{"m.id": nil}).
+		Where(squirrel.Eq{"b.status": bikerental.BikeStatusAvailable})
+	if query.Location != nil {
+		// Haversine formula, distance in meters.
+		subq = subq.
+			Column(
+				`2 * 6371000 * asin(sqrt(
+					power(sin(radians(s.lat - ?) / 2), 2) +
+					cos(radians(?)) * cos(radians(s.lat)) * power(sin(radians(s.long - ?) / 2), 2)
+				)) as distance`,
+				query.Location.Lat, query.Location.Lat, query.Location.Long,
+			).
+			Join("stations s on s.id = b.station_id")
+	} else {
+		subq = subq.Column("0 as distance")
+	}
+	if query.MinWeight > 0 {
+		subq = subq.Where(squirrel.GtOrEq{"b.weight": query.MinWeight})
+	}
+	if query.MaxWeight > 0 {
+		subq = subq.Where(squirrel.LtOrEq{"b.weight": query.MaxWeight})
+	}
+	if query.MaxPricePerHour > 0 {
+		subq = subq.Where(squirrel.LtOrEq{"b.price_per_h": query.MaxPricePerHour})
+	}
+
+	sqlq := sqlBuilder.Select("*").
+		FromSelect(subq, "a").
+		OrderBy("distance asc", "price_per_h asc", "id asc")
+	if query.Location != nil {
+		sqlq = sqlq.Where(squirrel.LtOrEq{"distance": query.Radius})
+	}
+	if query.Limit > 0 {
+		sqlq = sqlq.Limit(uint64(query.Limit))
+	}
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var bs []availableBikeModel
+	if err := r.db.SelectContext(ctx, &bs, q, args...); err != nil {
+		return nil, fmt.Errorf("querying for available bikes in postgresql: %w", err)
+	}
+
+	result := make([]bikerental.AvailableBike, 0, len(bs))
+	for _, b := range bs {
+		result = append(result, bikerental.AvailableBike{
+			Bike:     b.ToAppBike(),
+			Distance: b.Distance,
+		})
+	}
+	return result, nil
+}
+
 // Get returns a reservation by id.
 // Returns app.ErrNotFound if reservation doesn't exists.
 func (r *ReservationsRepository) Get(ctx context.Context, id string) (*bikerental.Reservation, error) {
@@ -95,12 +185,23 @@ func (r *ReservationsRepository) Get(ctx context.Context, id string) (*bikerenta
 
 // Create creates new reservation in db.
 // Bike id must be provided.
-// If customer doesn't exists, it is created with reservation.
+// If customer id is empty, customer is resolved by email. If it doesn't exists, it is created with reservation.
+// Returns app.ConflictError if the bike is not available, or was reserved by a concurrent transaction.
 func (r *ReservationsRepository) Create(ctx context.Context, reservation bikerental.Reservation) (*bikerental.Reservation, error) {
 	if err := r.checkReservationData(reservation); err != nil {
 		return nil, err
 	}
 
+	var result *bikerental.Reservation
+	err := retryOnCustomerCreated(func() error {
+		var err error
+		result, err = r.create(ctx, reservation)
+		return err
+	})
+	return result, mapSerializationFailure(err)
+}
+
+func (r *ReservationsRepository) create(ctx context.Context, reservation bikerental.Reservation) (*bikerental.Reservation, error) {
 	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
 		Isolation: sql.LevelRepeatableRead,
 	})
@@ -121,7 +222,7 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 	}
 	reservation.Bike = *bike
 
-	available, err := r.checkAvailability(ctx, tx, reservation.Bike.ID, reservation.StartTime, reservation.EndTime)
+	available, err := r.checkAvailability(ctx, tx, reservation.Bike.ID, reservation.StartTime, reservation.EndTime, "")
 	if err != nil {
 		return nil, fmt.Errorf("checking bike availability: %w", err)
 	}
@@ -129,23 +230,23 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 		return nil, app.NewConflictError("bike not available")
 	}
 
-	if reservation.Customer.ID != "" {
-		customer, err := r.parent.Customers().GetInTx(ctx, tx, reservation.Customer.ID)
-		if err != nil {
-			return nil, fmt.Errorf("invalid customer: %w", err)
-		}
-		reservation.Customer = *customer
-	} else {
-		reservation.Customer.ID = uuid.NewString()
-		if err := r.parent.Customers().CreateInTx(ctx, tx, reservation.Customer); err != nil {
-			return nil, fmt.Errorf("creating customer: %w", err)
-		}
+	customer, err := r.resolveCustomerInTx(ctx, tx, reservation.Customer)
+	if err != nil {
+		return nil, err
 	}
+	reservation.Customer = *customer
 
 	if err := r.createReservation(ctx, tx, reservation); err != nil {
 		return nil, fmt.Errorf("creating reservation: %w", err)
 	}
 
+	if reservation.PromoCode != "" {
+		err := r.parent.PromoCodes().RedeemInTx(ctx, tx, reservation.PromoCode, reservation.ID, reservation.Customer.ID, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("redeeming promo code: %w", err)
+		}
+	}
+
 	if err := commitTx(ctx, tx, r.log); err != nil {
 		return nil, fmt.Errorf("committing postgres transaction: %w", err)
 	}
@@ -153,6 +254,92 @@ func (r *ReservationsRepository) Create(ctx context.Context, reservation bikeren
 	return &reservation, nil
 }
 
+// CreateAll creates reservations in db in one transaction.
+// All reservations have to be for the same customer.
+// If any reservation is not available, nothing is created and indexes of conflicting reservations are returned.
+// Returns app.ConflictError if bikes were reserved by a concurrent transaction.
+func (r *ReservationsRepository) CreateAll(ctx context.Context, rs []bikerental.Reservation) ([]bikerental.Reservation, []int, error) {
+	if len(rs) == 0 {
+		return nil, nil, errors.New("empty reservations list")
+	}
+	for _, reservation := range rs {
+		if err := r.checkReservationData(reservation); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		result    []bikerental.Reservation
+		conflicts []int
+	)
+	err := retryOnCustomerCreated(func() error {
+		var err error
+		result, conflicts, err = r.createAll(ctx, rs)
+		return err
+	})
+	return result, conflicts, mapSerializationFailure(err)
+}
+
+func (r *ReservationsRepository) createAll(ctx context.Context, rs []bikerental.Reservation) ([]bikerental.Reservation, []int, error) {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	// Constraint checks have to be deffered to the end of the sql tx,
+	// because we might have to create new customer in current transaction.
+	if _, err := tx.ExecContext(ctx, "SET CONSTRAINTS ALL DEFERRED"); err != nil {
+		return nil, nil, fmt.Errorf("setting postgresql transaction constraints: %w", err)
+	}
+
+	bikesByID := make(map[string]*bikerental.Bike)
+	var conflicts []int
+	for i, reservation := range rs {
+		if _, ok := bikesByID[reservation.Bike.ID]; !ok {
+			bike, err := r.parent.Bikes().Get(ctx, reservation.Bike.ID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid bike: %w", err)
+			}
+			bikesByID[bike.ID] = bike
+		}
+
+		available, err := r.checkAvailability(ctx, tx, reservation.Bike.ID, reservation.StartTime, reservation.EndTime, "")
+		if err != nil {
+			return nil, nil, fmt.Errorf("checking bike availability: %w", err)
+		}
+		if !available {
+			conflicts = append(conflicts, i)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts, nil
+	}
+
+	customer, err := r.resolveCustomerInTx(ctx, tx, rs[0].Customer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]bikerental.Reservation, 0, len(rs))
+	for _, reservation := range rs {
+		reservation.Bike = *bikesByID[reservation.Bike.ID]
+		reservation.Customer = *customer
+		if err := r.createReservation(ctx, tx, reservation); err != nil {
+			return nil, nil, fmt.Errorf("creating reservation: %w", err)
+		}
+		result = append(result, reservation)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	return result, nil, nil
+}
+
 // Delete deletes reservation from db.
 func (r *ReservationsRepository) Delete(ctx context.Context, id string) error {
 	res, err := r.db.ExecContext(ctx, `delete from reservations where id=$1`, id)
@@ -169,36 +356,342 @@ func (r *ReservationsRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// SetStatus updates the status of the reservation by its id.
-// Returns app.ErrNotFound if reservation doesn't exists.
-func (r *ReservationsRepository) SetStatus(ctx context.Context, id string, status bikerental.ReservationStatus) error {
+// Update changes bike, time range and value of the reservation in a single transaction.
+// The reservation row is locked, and updated only if its status is still `reservation.Status`.
+// Time range freed by the change is offered to waitlist in the same transaction, and promoted reservations are returned.
+// Returns app.ConflictError if rows used by the update were changed by a concurrent transaction.
+func (r *ReservationsRepository) Update(ctx context.Context, reservation bikerental.Reservation) (*bikerental.Reservation, []bikerental.Reservation, error) {
+	result, promoted, err := r.update(ctx, reservation)
+	return result, promoted, mapSerializationFailure(err)
+}
+
+func (r *ReservationsRepository) update(ctx context.Context, reservation bikerental.Reservation) (*bikerental.Reservation, []bikerental.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	var current struct {
+		freedSlot
+		Status        bikerental.ReservationStatus `db:"status"`
+		HoldExpiresAt sql.NullTime                 `db:"hold_expires_at"`
+	}
+	err = tx.GetContext(
+		ctx,
+		&current,
+		"select bike_id, start_time, end_time, status, hold_expires_at from reservations where id=$1 for update",
+		reservation.ID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, app.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("querying postgres: %w", err)
+	}
+	if current.Status != reservation.Status {
+		return nil, nil, app.NewConflictError("reservation status has changed")
+	}
+	// Otherwise the hold would be expired by availability check in this transaction.
+	if current.Status == bikerental.ReservationStatusPending && !current.HoldExpiresAt.Time.After(time.Now()) {
+		return nil, nil, app.NewConflictError("reservation hold has expired")
+	}
+
+	bike, err := r.parent.Bikes().Get(ctx, reservation.Bike.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid bike: %w", err)
+	}
+	reservation.Bike = *bike
+
+	available, err := r.checkAvailability(ctx, tx, reservation.Bike.ID, reservation.StartTime, reservation.EndTime, reservation.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("checking bike availability: %w", err)
+	}
+	if !available {
+		return nil, nil, bikerental.ErrBikeNotAvailable
+	}
+
 	sqlq := sqlBuilder.Update("reservations").
-		Set("status", status).
-		Where(squirrel.Eq{"id": id})
+		Set("bike_id", reservation.Bike.ID).
+		Set("start_time", reservation.StartTime).
+		Set("end_time", reservation.EndTime).
+		Set("total_value", reservation.TotalValue).
+		Set("applied_discount", reservation.AppliedDiscount).
+		Set("discount_rule", reservation.DiscountRule).
+		Where(squirrel.Eq{"id": reservation.ID})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("building sql query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, q, args...); err != nil {
+		// Overlapping reservation could be created by concurrent transaction after availability check.
+		if isPgError(err, pgErrExclusionViolation) {
+			return nil, nil, bikerental.ErrBikeNotAvailable
+		}
+		return nil, nil, fmt.Errorf("updating reservation row in postgres: %w", err)
+	}
+
+	var promoted []bikerental.Reservation
+	if reservation.Bike.ID != current.BikeID ||
+		!reservation.StartTime.Equal(current.StartTime) || !reservation.EndTime.Equal(current.EndTime) {
+		promoted, err = r.parent.Waitlist().promoteFreedInTx(ctx, tx, []freedSlot{current.freedSlot})
+		if err != nil {
+			return nil, nil, fmt.Errorf("promoting waitlist entries: %w", err)
+		}
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", reservation.ID).
+		WithField("bikeId", reservation.Bike.ID).
+		Info("reservation updated in db")
+
+	return &reservation, promoted, nil
+}
+
+// UpdateStatus changes the status of the reservation, if its current status is `update.From`.
+// Returns app.ErrNotFound if reservation doesn't exists,
+// and app.ConflictError if reservation has other status.
+func (r *ReservationsRepository) UpdateStatus(ctx context.Context, update reservation.StatusUpdate) error {
+	return r.updateStatus(ctx, r.db, update)
+}
+
+// Release changes statuses of reservations like UpdateStatus, to statuses which don't block the bike,
+// and offers freed time ranges to waitlist. All changes are applied in a single transaction.
+// Returns promoted reservations.
+func (r *ReservationsRepository) Release(ctx context.Context, updates ...reservation.StatusUpdate) ([]bikerental.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	freed := make([]freedSlot, 0, len(updates))
+	for _, update := range updates {
+		if err := r.updateStatus(ctx, tx, update); err != nil {
+			return nil, err
+		}
+
+		var slot freedSlot
+		if err := tx.GetContext(ctx, &slot, "select bike_id, start_time, end_time from reservations where id=$1", update.ID); err != nil {
+			return nil, fmt.Errorf("querying postgres: %w", err)
+		}
+		freed = append(freed, slot)
+	}
+
+	promoted, err := r.parent.Waitlist().promoteFreedInTx(ctx, tx, freed)
+	if err != nil {
+		return nil, fmt.Errorf("promoting waitlist entries: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// ExpireHolds changes status of pending reservations with hold expired at time `t` to expired.
+// Promo code redemptions of expired reservations are released.
+// Freed time ranges are offered to waitlist in the same transaction, and promoted reservations are returned.
+func (r *ReservationsRepository) ExpireHolds(ctx context.Context, t time.Time) (int, []bikerental.Reservation, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	expired, err := r.expireHolds(ctx, tx, "", t)
+	if err != nil {
+		return 0, nil, err
+	}
+	promoted, err := r.parent.Waitlist().promoteFreedInTx(ctx, tx, expired)
+	if err != nil {
+		return 0, nil, fmt.Errorf("promoting waitlist entries: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return 0, nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	if len(expired) > 0 {
+		app.AugmentLogFromCtx(ctx, r.log).WithField("expired", len(expired)).Info("reservation holds expired in db")
+	}
+	return len(expired), promoted, nil
+}
+
+// Complete changes the status of the reservation like UpdateStatus, and saves its invoice in the same transaction.
+func (r *ReservationsRepository) Complete(ctx context.Context, update reservation.StatusUpdate, invoice bikerental.Invoice) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	if err := r.updateStatus(ctx, tx, update); err != nil {
+		return err
+	}
+
+	sqlq := sqlBuilder.Insert("invoices").
+		Columns("reservation_id", "reservation_value", "discount", "late_fee", "late_hours", "total_value", "created_at").
+		Values(
+			squirrel.Expr(":reservation_id"),
+			squirrel.Expr(":reservation_value"),
+			squirrel.Expr(":discount"),
+			squirrel.Expr(":late_fee"),
+			squirrel.Expr(":late_hours"),
+			squirrel.Expr(":total_value"),
+			squirrel.Expr(":created_at"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+	if _, err := tx.NamedExecContext(ctx, q, invoiceModel(invoice)); err != nil {
+		return fmt.Errorf("inserting invoice row into postgres: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", update.ID).
+		WithField("totalValue", invoice.TotalValue).
+		Info("invoice created in db")
+
+	return nil
+}
+
+// GetInvoice returns invoice for a reservation.
+// Returns app.ErrNotFound if invoice doesn't exists.
+func (r *ReservationsRepository) GetInvoice(ctx context.Context, reservationID string) (*bikerental.Invoice, error) {
+	var m invoiceModel
+	if err := r.db.GetContext(ctx, &m, "select * from invoices where reservation_id=$1", reservationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := bikerental.Invoice(m)
+	return &result, nil
+}
+
+// expireHolds expires pending reservations with hold expired at time `t`, and releases their promo code redemptions.
+// If bike id is not empty, only reservations of that bike are expired.
+// Returns time ranges freed by expired reservations.
+func (r *ReservationsRepository) expireHolds(ctx context.Context, db sqlx.QueryerContext, bikeID string, t time.Time) ([]freedSlot, error) {
+	cond := squirrel.And{
+		squirrel.Eq{"status": bikerental.ReservationStatusPending},
+		squirrel.LtOrEq{"hold_expires_at": t},
+	}
+	if bikeID != "" {
+		cond = append(cond, squirrel.Eq{"bike_id": bikeID})
+	}
+	where, args, err := cond.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	query := `
+		with expired as (
+			update reservations set status = ? where ` + where + ` returning id, bike_id, start_time, end_time
+		), released as (
+			delete from promo_code_redemptions where reservation_id in (select id from expired)
+		)
+		select bike_id, start_time, end_time from expired`
+	query, err = squirrel.Dollar.ReplacePlaceholders(query)
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var expired []freedSlot
+	if err := sqlx.SelectContext(ctx, db, &expired, query, append([]interface{}{bikerental.ReservationStatusExpired}, args...)...); err != nil {
+		return nil, fmt.Errorf("expiring reservation holds in postgres: %w", err)
+	}
+	return expired, nil
+}
+
+func (r *ReservationsRepository) updateStatus(ctx context.Context, db sqlx.ExecerContext, update reservation.StatusUpdate) error {
+	sqlq := sqlBuilder.Update("reservations").
+		Set("status", update.To).
+		Where(squirrel.Eq{"id": update.ID}).
+		Where(squirrel.Eq{"status": update.From})
+	if !update.PickedUpAt.IsZero() {
+		sqlq = sqlq.Set("picked_up_at", update.PickedUpAt)
+	}
+	if !update.ReturnedAt.IsZero() {
+		sqlq = sqlq.Set("returned_at", update.ReturnedAt)
+	}
+	if !update.HeldAt.IsZero() {
+		sqlq = sqlq.Where(squirrel.Gt{"hold_expires_at": update.HeldAt})
+	}
+	if c := update.Cancellation; c != nil {
+		sqlq = sqlq.
+			Set("refund", c.Refund).
+			Set("cancellation_fee", c.Fee).
+			Set("canceled_at", c.CanceledAt)
+	}
 	q, args, err := sqlq.ToSql()
 	if err != nil {
 		return fmt.Errorf("building sql query: %w", err)
 	}
 
-	res, err := r.db.ExecContext(ctx, q, args...)
+	res, err := db.ExecContext(ctx, q, args...)
 	if err != nil {
 		return fmt.Errorf("updating reservation status in postgres: %w", err)
 	}
 
 	rows, _ := res.RowsAffected()
 	if rows == 0 {
-		return app.ErrNotFound
+		// Reservation doesn't exist, or its status was changed in the meantime.
+		if _, err := r.Get(ctx, update.ID); err != nil {
+			return err
+		}
+		return app.NewConflictError("reservation status has changed")
 	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", update.ID).
+		WithField("status", update.To).
+		Info("reservation status updated in db")
+
 	return nil
 }
 
-func (r *ReservationsRepository) checkAvailability(ctx context.Context, tx *sqlx.Tx, bikeID string, startTime, endTime time.Time) (bool, error) {
+// checkAvailability returns true if the bike has no blocking reservations or maintenance windows in given time range.
+// Reservation with id `excludeID` is ignored, if it's not empty.
+// Expired holds of the bike are released first, so they don't block new reservations in the no-overlap constraint.
+func (r *ReservationsRepository) checkAvailability(ctx context.Context, tx *sqlx.Tx, bikeID string, startTime, endTime time.Time, excludeID string) (bool, error) {
+	// Lock the bike, so reservations and maintenance windows of one bike are scheduled one at a time.
+	var id string
+	if err := tx.GetContext(ctx, &id, "select id from bikes where id=$1 for update", bikeID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, app.ErrNotFound
+		}
+		return false, fmt.Errorf("locking bike row in postgres: %w", err)
+	}
+
+	// Time ranges freed by holds expired here are not offered to waitlist, because they are being taken.
+	if _, err := r.expireHolds(ctx, tx, bikeID, time.Now()); err != nil {
+		return false, err
+	}
+
 	sqlq := sqlBuilder.Select("count(*)").
 		From("reservations").
 		Where(squirrel.Eq{"bike_id": bikeID}).
 		Where(squirrel.Gt{"end_time": startTime}).
 		Where(squirrel.Lt{"start_time": endTime}).
-		Where(squirrel.NotEq{"status": bikerental.ReservationStatusCanceled})
+		Where(squirrel.NotEq{"status": releasedStatuses})
+	if excludeID != "" {
+		sqlq = sqlq.Where(squirrel.NotEq{"id": excludeID})
+	}
 	q, args, err := sqlq.ToSql()
 	if err != nil {
 		return false, fmt.Errorf("building sql query: %w", err)
@@ -218,7 +711,51 @@ func (r *ReservationsRepository) checkAvailability(ctx context.Context, tx *sqlx
 	if count > 0 {
 		return false, nil
 	}
-	return true, nil
+
+	maintenance, err := r.parent.Maintenance().hasOverlappingInTx(ctx, tx, bikeID, startTime, endTime)
+	if err != nil {
+		return false, fmt.Errorf("checking bike maintenance windows: %w", err)
+	}
+	return !maintenance, nil
+}
+
+// resolveCustomerInTx returns customer by id, or by email if id is empty.
+// Customer not found by email is created.
+func (r *ReservationsRepository) resolveCustomerInTx(ctx context.Context, tx *sqlx.Tx, c bikerental.Customer) (*bikerental.Customer, error) {
+	if c.ID != "" {
+		customer, err := r.parent.Customers().GetInTx(ctx, tx, c.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid customer: %w", err)
+		}
+		return customer, nil
+	}
+
+	customer, err := r.parent.Customers().GetByEmailInTx(ctx, tx, c.Email)
+	switch {
+	case err == nil:
+		return customer, nil
+	case app.IsNotFoundError(err):
+		c.ID = uuid.NewString()
+		if err := r.parent.Customers().CreateInTx(ctx, tx, c); err != nil {
+			if errors.Is(err, errCustomerEmailExists) {
+				return nil, errCustomerCreatedConcurrently
+			}
+			return nil, fmt.Errorf("creating customer: %w", err)
+		}
+		return &c, nil
+	default:
+		return nil, fmt.Errorf("checking customer by email: %w", err)
+	}
+}
+
+// retryOnCustomerCreated calls f once more if it failed, because customer with the same email
+// was created by a concurrent transaction. Customer is then resolved by email in a new transaction.
+func retryOnCustomerCreated(f func() error) error {
+	err := f()
+	if errors.Is(err, errCustomerCreatedConcurrently) {
+		err = f()
+	}
+	return err
 }
 
 func (r *ReservationsRepository) checkReservationData(reservation bikerental.Reservation) error {
@@ -237,7 +774,7 @@ func (r *ReservationsRepository) checkReservationData(reservation bikerental.Res
 func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx.Tx, reservation bikerental.Reservation) error {
 	sqlq := sqlBuilder.
 		Insert("reservations").
-		Columns("id", "status", "bike_id", "customer_id", "start_time", "end_time", "total_value", "applied_discount").
+		Columns("id", "status", "bike_id", "customer_id", "start_time", "end_time", "total_value", "applied_discount", "discount_rule", "promo_code", "series_id", "group_id", "hold_expires_at").
 		Values(
 			squirrel.Expr(":id"),
 			squirrel.Expr(":status"),
@@ -247,6 +784,11 @@ func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx
 			squirrel.Expr(":end_time"),
 			squirrel.Expr(":total_value"),
 			squirrel.Expr(":applied_discount"),
+			squirrel.Expr(":discount_rule"),
+			squirrel.Expr(":promo_code"),
+			squirrel.Expr(":series_id"),
+			squirrel.Expr(":group_id"),
+			squirrel.Expr(":hold_expires_at"),
 		)
 	q, _, err := sqlq.ToSql()
 	if err != nil {
@@ -255,6 +797,10 @@ func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx
 
 	m := newReservationModel(reservation)
 	if _, err := tx.NamedExec(q, m); err != nil {
+		// Overlapping reservation could be created by concurrent transaction after availability check.
+		if isPgError(err, pgErrExclusionViolation) {
+			return app.NewConflictError("bike not available")
+		}
 		return fmt.Errorf("inserting reservation row into postgres: %w", err)
 	}
 
@@ -267,15 +813,40 @@ func (r *ReservationsRepository) createReservation(ctx context.Context, tx *sqlx
 	return nil
 }
 
+type availableBikeModel struct {
+	bikeModel
+	Distance float64 `db:"distance"`
+}
+
+type invoiceModel struct {
+	ReservationID    string    `db:"reservation_id"`
+	ReservationValue int       `db:"reservation_value"`
+	Discount         int       `db:"discount"`
+	LateFee          int       `db:"late_fee"`
+	LateHours        int       `db:"late_hours"`
+	TotalValue       int       `db:"total_value"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
 type reservationModel struct {
-	ID              string    `db:"id"`
-	Status          string    `db:"status"`
-	BikeID          string    `db:"bike_id"`
-	CustomerID      string    `db:"customer_id"`
-	StartTime       time.Time `db:"start_time"`
-	EndTime         time.Time `db:"end_time"`
-	TotalValue      int       `db:"total_value"`
-	AppliedDiscount int       `db:"applied_discount"`
+	ID              string         `db:"id"`
+	Status          string         `db:"status"`
+	BikeID          string         `db:"bike_id"`
+	CustomerID      string         `db:"customer_id"`
+	StartTime       time.Time      `db:"start_time"`
+	EndTime         time.Time      `db:"end_time"`
+	TotalValue      int            `db:"total_value"`
+	AppliedDiscount int            `db:"applied_discount"`
+	DiscountRule    string         `db:"discount_rule"`
+	PromoCode       string         `db:"promo_code"`
+	PickedUpAt      sql.NullTime   `db:"picked_up_at"`
+	ReturnedAt      sql.NullTime   `db:"returned_at"`
+	SeriesID        sql.NullString `db:"series_id"`
+	GroupID         sql.NullString `db:"group_id"`
+	HoldExpiresAt   sql.NullTime   `db:"hold_expires_at"`
+	Refund          int            `db:"refund"`
+	CancellationFee int            `db:"cancellation_fee"`
+	CanceledAt      sql.NullTime   `db:"canceled_at"`
 
 	// Join on customers
 	FirstName string `db:"first_name"`
@@ -299,6 +870,11 @@ func newReservationModel(ar bikerental.Reservation) reservationModel {
 		EndTime:         ar.EndTime,
 		TotalValue:      ar.TotalValue,
 		AppliedDiscount: ar.AppliedDiscount,
+		DiscountRule:    ar.DiscountRule,
+		PromoCode:       ar.PromoCode,
+		SeriesID:        sql.NullString{String: ar.SeriesID, Valid: ar.SeriesID != ""},
+		GroupID:         sql.NullString{String: ar.GroupID, Valid: ar.GroupID != ""},
+		HoldExpiresAt:   sql.NullTime{Time: ar.HoldExpiresAt, Valid: !ar.HoldExpiresAt.IsZero()},
 	}
 }
 
@@ -325,5 +901,15 @@ func (m *reservationModel) ToAppReservation() bikerental.Reservation {
 		EndTime:         m.EndTime,
 		TotalValue:      m.TotalValue,
 		AppliedDiscount: m.AppliedDiscount,
+		DiscountRule:    m.DiscountRule,
+		PromoCode:       m.PromoCode,
+		PickedUpAt:      m.PickedUpAt.Time,
+		ReturnedAt:      m.ReturnedAt.Time,
+		SeriesID:        m.SeriesID.String,
+		GroupID:         m.GroupID.String,
+		HoldExpiresAt:   m.HoldExpiresAt.Time,
+		Refund:          m.Refund,
+		CancellationFee: m.CancellationFee,
+		CanceledAt:      m.CanceledAt.Time,
 	}
 }
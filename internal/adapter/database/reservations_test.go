@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+func TestReservationsCreateConcurrently(t *testing.T) {
+	a := newTestAdapter(t)
+	ctx := context.Background()
+
+	bike := bikerental.Bike{
+		ID:           uuid.NewString(),
+		ModelName:    "Concurrency test bike",
+		Weight:       12,
+		PricePerHour: 1000,
+		Status:       bikerental.BikeStatusAvailable,
+	}
+	if err := a.Bikes().Create(ctx, bike); err != nil {
+		t.Fatalf("creating bike: %v", err)
+	}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	const n = 10
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, n)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = a.Reservations().Create(ctx, bikerental.Reservation{
+				ID:     uuid.NewString(),
+				Status: bikerental.ReservationStatusApproved,
+				Customer: bikerental.Customer{
+					Type:      bikerental.CustomerTypeIndividual,
+					FirstName: "John",
+					Email:     fmt.Sprintf("concurrency-%s@example.com", uuid.NewString()),
+				},
+				Bike:       bike,
+				StartTime:  start,
+				EndTime:    start.Add(2 * time.Hour),
+				TotalValue: 2000,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	var created int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !app.IsConflictError(err):
+			t.Errorf("Create() error = %v, want conflict error", err)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("created %d reservations, want exactly 1", created)
+	}
+}
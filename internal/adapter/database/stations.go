@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// StationsRepository manages rental stations in db.
+type StationsRepository struct {
+	db  *sqlx.DB
+	log logrus.FieldLogger
+}
+
+// List returns list of all stations from db sorted by name ascending.
+func (r *StationsRepository) List(ctx context.Context) ([]bikerental.Station, error) {
+	var ss []stationModel
+	if err := r.db.SelectContext(ctx, &ss, "select * from stations order by name asc"); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.Station, 0, len(ss))
+	for _, st := range ss {
+		result = append(result, st.ToAppStation())
+	}
+	return result, nil
+}
+
+// Get returns a station by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *StationsRepository) Get(ctx context.Context, id string) (*bikerental.Station, error) {
+	var st stationModel
+	if err := r.db.GetContext(ctx, &st, "select * from stations where id=$1", id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := st.ToAppStation()
+	return &result, nil
+}
+
+// Create creates new station in db.
+func (r *StationsRepository) Create(ctx context.Context, st bikerental.Station) error {
+	sqlq := sqlBuilder.Insert("stations").
+		Columns("id", "name", "lat", "long", "capacity").
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":name"),
+			squirrel.Expr(":lat"),
+			squirrel.Expr(":long"),
+			squirrel.Expr(":capacity"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	if _, err = r.db.NamedExecContext(ctx, q, newStationModel(st)); err != nil {
+		return fmt.Errorf("inserting station row into postgres: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", st.ID).Info("station created in db")
+
+	return nil
+}
+
+// Update updates a station in db by id. If station is not in db, returns app.ErrNotFound error.
+func (r *StationsRepository) Update(ctx context.Context, id string, st bikerental.Station) error {
+	sqlq := sqlBuilder.Update("stations").
+		Set("name", st.Name).
+		Set("lat", st.Location.Lat).
+		Set("long", st.Location.Long).
+		Set("capacity", st.Capacity).
+		Where(squirrel.Eq{"id": id})
+	q, args, err := sqlq.ToSql()
+	if err != nil {
+		return fmt.Errorf("building sql query: %w", err)
+	}
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("updating station row in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("station updated in db")
+
+	return nil
+}
+
+// Delete deletes a station from db by id. If station is not in db, returns app.ErrNotFound error.
+// Returns app.ConflictError if any bike is assigned to the station.
+func (r *StationsRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `delete from stations where id=$1`, id)
+	if err != nil {
+		if isPgError(err, pgErrForeignKeyViolation) {
+			return app.NewConflictError("station has assigned bikes")
+		}
+		return fmt.Errorf("deleting station row from postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return app.ErrNotFound
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("station deleted from db")
+
+	return nil
+}
+
+type stationModel struct {
+	ID       string  `db:"id"`
+	Name     string  `db:"name"`
+	Lat      float64 `db:"lat"`
+	Long     float64 `db:"long"`
+	Capacity int     `db:"capacity"`
+}
+
+func newStationModel(st bikerental.Station) stationModel {
+	return stationModel{
+		ID:       st.ID,
+		Name:     st.Name,
+		Lat:      st.Location.Lat,
+		Long:     st.Location.Long,
+		Capacity: st.Capacity,
+	}
+}
+
+func (m *stationModel) ToAppStation() bikerental.Station {
+	return bikerental.Station{
+		ID:   m.ID,
+		Name: m.Name,
+		Location: bikerental.Location{
+			Lat:  m.Lat,
+			Long: m.Long,
+		},
+		Capacity: m.Capacity,
+	}
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/nglogic/go-application-guide/internal/adapter/database")
+
+// tracedConnector wraps sql driver connector, so every sql statement executed on its connections is traced in a span.
+type tracedConnector struct {
+	driver.Connector
+	tracer trace.Tracer
+}
+
+// Connect returns traced connection.
+func (c tracedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedConn{Conn: conn, tracer: c.tracer}, nil
+}
+
+// tracedConn traces statements executed directly on the connection.
+// Optional driver interfaces are passed to the wrapped connection,
+// or sql package defaults are used, like falling back to prepared statements on driver.ErrSkip.
+type tracedConn struct {
+	driver.Conn
+	tracer trace.Tracer
+}
+
+// BeginTx implements driver.ConnBeginTx.
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin() //nolint:staticcheck // Fallback for drivers without context support.
+}
+
+// ExecContext implements driver.ExecerContext.
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Result, err error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	_, span := c.startStatementSpan(ctx, query)
+	defer func() { endStatementSpan(span, err) }()
+
+	return execer.ExecContext(ctx, query, args)
+}
+
+// QueryContext implements driver.QueryerContext.
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Rows, err error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	_, span := c.startStatementSpan(ctx, query)
+	defer func() { endStatementSpan(span, err) }()
+
+	return queryer.QueryContext(ctx, query, args)
+}
+
+// Ping implements driver.Pinger.
+func (c *tracedConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// PrepareContext implements driver.ConnPrepareContext.
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return p.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+// CheckNamedValue implements driver.NamedValueChecker.
+func (c *tracedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	// ErrSkip makes sql package convert the value with default converter.
+	return driver.ErrSkip
+}
+
+// ResetSession implements driver.SessionResetter.
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+// IsValid implements driver.Validator.
+func (c *tracedConn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (c *tracedConn) startStatementSpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	query = strings.TrimSpace(query)
+	name := "sql"
+	if i := strings.IndexAny(query, " \n\t"); i > 0 {
+		name = "sql " + strings.ToLower(query[:i])
+	}
+
+	return c.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", query),
+		),
+	)
+}
+
+func endStatementSpan(span trace.Span, err error) {
+	// ErrSkip only means that the statement will be executed in a different way.
+	if errors.Is(err, driver.ErrSkip) {
+		err = nil
+	}
+	tracing.EndSpan(span, err)
+}
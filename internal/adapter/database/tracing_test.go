@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errFakeQuery = errors.New("fake query failed")
+
+// fakeConnector returns fakeConn connections.
+type fakeConnector struct {
+	conn *fakeConn
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func (c fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+// fakeConn executes statements without a database, failing those equal to failingQuery.
+// It records calls of optional driver interfaces.
+type fakeConn struct {
+	failingQuery string
+
+	checkedValues int
+	resets        int
+	validated     int
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if query == c.failingQuery {
+		return nil, errFakeQuery
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if query == c.failingQuery {
+		return nil, errFakeQuery
+	}
+	return emptyRows{}, nil
+}
+
+func (c *fakeConn) CheckNamedValue(*driver.NamedValue) error {
+	c.checkedValues++
+	return nil
+}
+
+func (c *fakeConn) ResetSession(context.Context) error {
+	c.resets++
+	return nil
+}
+
+func (c *fakeConn) IsValid() bool {
+	c.validated++
+	return true
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string              { return []string{"id"} }
+func (emptyRows) Close() error                   { return nil }
+func (emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+func newTracedTestDB(t *testing.T, conn *fakeConn) (*sql.DB, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(context.Background())
+	})
+
+	db := sql.OpenDB(tracedConnector{Connector: fakeConnector{conn: conn}, tracer: tp.Tracer("test")})
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db, exp
+}
+
+func TestTracedConnStatementSpans(t *testing.T) {
+	const failingQuery = "delete from bikes"
+	db, exp := newTracedTestDB(t, &fakeConn{failingQuery: failingQuery})
+	ctx := context.Background()
+
+	if _, err := db.ExecContext(ctx, "  insert into bikes values ($1)", "id"); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+	rows, err := db.QueryContext(ctx, "select id from bikes")
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	_ = rows.Close()
+	if _, err := db.ExecContext(ctx, failingQuery); !errors.Is(err, errFakeQuery) {
+		t.Fatalf("exec error = %v, want %v", err, errFakeQuery)
+	}
+
+	spans := exp.GetSpans()
+	want := []struct {
+		name      string
+		statement string
+		status    codes.Code
+	}{
+		{name: "sql insert", statement: "insert into bikes values ($1)", status: codes.Unset},
+		{name: "sql select", statement: "select id from bikes", status: codes.Unset},
+		{name: "sql delete", statement: failingQuery, status: codes.Error},
+	}
+	if len(spans) != len(want) {
+		t.Fatalf("exported %d spans, want %d", len(spans), len(want))
+	}
+	for i, w := range want {
+		s := spans[i]
+		if s.Name != w.name {
+			t.Errorf("span %d name = %q, want %q", i, s.Name, w.name)
+		}
+		var statement string
+		for _, a := range s.Attributes {
+			if a.Key == "db.statement" {
+				statement = a.Value.AsString()
+			}
+		}
+		if statement != w.statement {
+			t.Errorf("span %d statement = %q, want %q", i, statement, w.statement)
+		}
+		if s.Status.Code != w.status {
+			t.Errorf("span %d status = %v, want %v", i, s.Status.Code, w.status)
+		}
+	}
+}
+
+func TestTracedConnStatementSpanParent(t *testing.T) {
+	db, exp := newTracedTestDB(t, &fakeConn{})
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	defer parent.End()
+
+	if _, err := db.ExecContext(ctx, "update bikes set id = id"); err != nil {
+		t.Fatalf("exec: %v", err)
+	}
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("exported %d spans, want 1", len(spans))
+	}
+	if spans[0].Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Fatalf("statement span parent = %s, want %s", spans[0].Parent.SpanID(), parent.SpanContext().SpanID())
+	}
+}
+
+func TestTracedConnForwardsOptionalInterfaces(t *testing.T) {
+	conn := &fakeConn{}
+	db, _ := newTracedTestDB(t, conn)
+	ctx := context.Background()
+
+	// The connection is returned to the pool after the first statement,
+	// so it's validated and its session is reset before reusing it.
+	for i := 0; i < 2; i++ {
+		if _, err := db.ExecContext(ctx, "update bikes set id = $1", "id"); err != nil {
+			t.Fatalf("exec: %v", err)
+		}
+	}
+
+	if conn.checkedValues == 0 {
+		t.Error("CheckNamedValue wasn't forwarded")
+	}
+	if conn.resets == 0 {
+		t.Error("ResetSession wasn't forwarded")
+	}
+	if conn.validated == 0 {
+		t.Error("IsValid wasn't forwarded")
+	}
+}
+
+func TestTracedConnPrepareContext(t *testing.T) {
+	c := &tracedConn{Conn: &fakeConn{}}
+
+	if _, err := c.PrepareContext(context.Background(), "select 1"); err == nil || err.Error() != "prepare not supported" {
+		t.Fatalf("PrepareContext() error = %v, want error of wrapped connection", err)
+	}
+}
@@ -0,0 +1,365 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+	"github.com/sirupsen/logrus"
+)
+
+// WaitlistRepository manages waitlist entries in db.
+type WaitlistRepository struct {
+	parent *Adapter
+	db     *sqlx.DB
+	log    logrus.FieldLogger
+}
+
+// List returns all waitlist entries for a bike, sorted by creation time.
+func (r *WaitlistRepository) List(ctx context.Context, bikeID string) ([]bikerental.WaitlistEntry, error) {
+	q, args, err := r.selectEntries().
+		Where(squirrel.Eq{"w.bike_id": bikeID}).
+		OrderBy("w.created_at asc", "w.id asc").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var ms []waitlistEntryModel
+	if err := r.db.SelectContext(ctx, &ms, q, args...); err != nil {
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := make([]bikerental.WaitlistEntry, 0, len(ms))
+	for _, m := range ms {
+		result = append(result, m.ToAppWaitlistEntry())
+	}
+	return result, nil
+}
+
+// Get returns a waitlist entry by id. If it doesn't exists, returns app.ErrNotFound error.
+func (r *WaitlistRepository) Get(ctx context.Context, id string) (*bikerental.WaitlistEntry, error) {
+	q, args, err := r.selectEntries().Where(squirrel.Eq{"w.id": id}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var m waitlistEntryModel
+	if err := r.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, app.ErrNotFound
+		}
+		return nil, fmt.Errorf("querying postgres: %w", err)
+	}
+
+	result := m.ToAppWaitlistEntry()
+	return &result, nil
+}
+
+// Create creates new waitlist entry in db.
+// If customer id is empty, customer is resolved by email. If it doesn't exists, it is created with the entry.
+func (r *WaitlistRepository) Create(ctx context.Context, entry bikerental.WaitlistEntry) (*bikerental.WaitlistEntry, error) {
+	var result *bikerental.WaitlistEntry
+	err := retryOnCustomerCreated(func() error {
+		var err error
+		result, err = r.create(ctx, entry)
+		return err
+	})
+	return result, err
+}
+
+func (r *WaitlistRepository) create(ctx context.Context, entry bikerental.WaitlistEntry) (*bikerental.WaitlistEntry, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating postgresql transaction: %w", err)
+	}
+	defer rollbackTx(ctx, tx, r.log) // This will be noop after successful commit.
+
+	// Constraint checks have to be deffered to the end of the sql tx,
+	// because we might have to create new customer in current transaction.
+	if _, err := tx.ExecContext(ctx, "SET CONSTRAINTS ALL DEFERRED"); err != nil {
+		return nil, fmt.Errorf("setting postgresql transaction constraints: %w", err)
+	}
+
+	customer, err := r.parent.Reservations().resolveCustomerInTx(ctx, tx, entry.Customer)
+	if err != nil {
+		return nil, err
+	}
+	entry.Customer = *customer
+	entry.CreatedAt = time.Now()
+
+	sqlq := sqlBuilder.Insert("waitlist_entries").
+		Columns(
+			"id", "status", "bike_id", "customer_id", "lat", "long", "start_time", "end_time",
+			"total_value", "applied_discount", "discount_rule", "created_at",
+		).
+		Values(
+			squirrel.Expr(":id"),
+			squirrel.Expr(":status"),
+			squirrel.Expr(":bike_id"),
+			squirrel.Expr(":customer_id"),
+			squirrel.Expr(":lat"),
+			squirrel.Expr(":long"),
+			squirrel.Expr(":start_time"),
+			squirrel.Expr(":end_time"),
+			squirrel.Expr(":total_value"),
+			squirrel.Expr(":applied_discount"),
+			squirrel.Expr(":discount_rule"),
+			squirrel.Expr(":created_at"),
+		)
+	q, _, err := sqlq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+	if _, err := tx.NamedExecContext(ctx, q, newWaitlistEntryModel(entry)); err != nil {
+		return nil, fmt.Errorf("inserting waitlist entry row into postgres: %w", err)
+	}
+
+	if err := commitTx(ctx, tx, r.log); err != nil {
+		return nil, fmt.Errorf("committing postgres transaction: %w", err)
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).
+		WithField("id", entry.ID).
+		WithField("bikeId", entry.BikeID).
+		WithField("customerId", entry.Customer.ID).
+		Info("waitlist entry created in db")
+
+	return &entry, nil
+}
+
+// Withdraw changes waiting entry status to withdrawn.
+// Returns app.ErrNotFound if entry doesn't exist, and app.ConflictError if it's not waiting.
+func (r *WaitlistRepository) Withdraw(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(
+		ctx,
+		`update waitlist_entries set status=$1 where id=$2 and status=$3`,
+		bikerental.WaitlistStatusWithdrawn, id, bikerental.WaitlistStatusWaiting,
+	)
+	if err != nil {
+		return fmt.Errorf("updating waitlist entry in postgres: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		// Entry doesn't exist, or it's not waiting anymore.
+		if _, err := r.Get(ctx, id); err != nil {
+			return err
+		}
+		return app.NewConflictError("waitlist entry is not waiting")
+	}
+
+	app.AugmentLogFromCtx(ctx, r.log).WithField("id", id).Info("waitlist entry withdrawn in db")
+
+	return nil
+}
+
+// freedSlot is a bike time range freed by canceled, expired or rescheduled reservation, or canceled maintenance window.
+type freedSlot struct {
+	BikeID    string    `db:"bike_id"`
+	StartTime time.Time `db:"start_time"`
+	EndTime   time.Time `db:"end_time"`
+}
+
+// promoteFreedInTx offers every freed slot to the oldest fitting waitlist entry, see promoteInTx.
+// It has to be called in the same transaction in which slots were freed.
+// Returns created reservations.
+func (r *WaitlistRepository) promoteFreedInTx(ctx context.Context, tx *sqlx.Tx, slots []freedSlot) ([]bikerental.Reservation, error) {
+	var promoted []bikerental.Reservation
+	for _, slot := range slots {
+		res, err := r.promoteInTx(ctx, tx, slot.BikeID, slot.StartTime, slot.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			promoted = append(promoted, *res)
+		}
+	}
+	return promoted, nil
+}
+
+// promoteInTx converts the oldest waiting entry overlapping with given time range into approved reservation,
+// if the bike is in service, and it's available for the entry, including maintenance windows.
+// Entries starting in the past are skipped.
+// Notification for the customer is recorded with the reservation.
+// Returns created reservation, or nil if no entry was promoted.
+func (r *WaitlistRepository) promoteInTx(ctx context.Context, tx *sqlx.Tx, bikeID string, startTime, endTime time.Time) (*bikerental.Reservation, error) {
+	bike, err := r.parent.Bikes().Get(ctx, bikeID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching bike: %w", err)
+	}
+	if !bike.InService() {
+		return nil, nil
+	}
+
+	q, args, err := r.selectEntries().
+		Where(squirrel.Eq{"w.bike_id": bikeID}).
+		Where(squirrel.Eq{"w.status": bikerental.WaitlistStatusWaiting}).
+		Where(squirrel.Lt{"w.start_time": endTime}).
+		Where(squirrel.Gt{"w.end_time": startTime}).
+		Where(squirrel.Gt{"w.start_time": time.Now()}).
+		OrderBy("w.created_at asc", "w.id asc").
+		Suffix("for update of w").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sql query: %w", err)
+	}
+
+	var candidates []waitlistEntryModel
+	if err := tx.SelectContext(ctx, &candidates, q, args...); err != nil {
+		return nil, fmt.Errorf("querying for waitlist entries in postgres: %w", err)
+	}
+
+	reservations := r.parent.Reservations()
+	for _, c := range candidates {
+		entry := c.ToAppWaitlistEntry()
+
+		available, err := reservations.checkAvailability(ctx, tx, bikeID, entry.StartTime, entry.EndTime, "")
+		if err != nil {
+			return nil, fmt.Errorf("checking bike availability: %w", err)
+		}
+		if !available {
+			continue
+		}
+
+		res := bikerental.Reservation{
+			ID:              uuid.NewString(),
+			Status:          bikerental.ReservationStatusApproved,
+			Customer:        entry.Customer,
+			Bike:            *bike,
+			StartTime:       entry.StartTime,
+			EndTime:         entry.EndTime,
+			TotalValue:      entry.TotalValue,
+			AppliedDiscount: entry.AppliedDiscount,
+			DiscountRule:    entry.DiscountRule,
+		}
+
+		// Savepoint keeps the transaction usable if concurrent reservation took the slot after availability check.
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT waitlist_promotion"); err != nil {
+			return nil, fmt.Errorf("creating postgres savepoint: %w", err)
+		}
+		if err := reservations.createReservation(ctx, tx, res); err != nil {
+			if app.IsConflictError(err) {
+				if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT waitlist_promotion"); err != nil {
+					return nil, fmt.Errorf("rolling back to postgres savepoint: %w", err)
+				}
+				continue
+			}
+			return nil, fmt.Errorf("creating reservation: %w", err)
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			`update waitlist_entries set status=$1, reservation_id=$2 where id=$3`,
+			bikerental.WaitlistStatusPromoted, res.ID, entry.ID,
+		); err != nil {
+			return nil, fmt.Errorf("updating waitlist entry in postgres: %w", err)
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			`insert into notifications (id, customer_id, kind, message, reservation_id) values ($1, $2, $3, $4, $5)`,
+			uuid.NewString(),
+			entry.Customer.ID,
+			bikerental.NotificationKindWaitlistPromoted,
+			fmt.Sprintf(
+				"Bike %s is now reserved for you from %s to %s.",
+				bike.ModelName, entry.StartTime.Format(time.RFC3339), entry.EndTime.Format(time.RFC3339),
+			),
+			res.ID,
+		); err != nil {
+			return nil, fmt.Errorf("inserting notification row into postgres: %w", err)
+		}
+
+		app.AugmentLogFromCtx(ctx, r.log).
+			WithField("id", entry.ID).
+			WithField("reservationId", res.ID).
+			Info("waitlist entry promoted in db")
+
+		return &res, nil
+	}
+
+	return nil, nil
+}
+
+func (r *WaitlistRepository) selectEntries() squirrel.SelectBuilder {
+	return sqlBuilder.Select(
+		"w.*",
+		"c.first_name", "c.surname", "c.email", "c.type",
+	).
+		From("waitlist_entries w").
+		Join("customers c on w.customer_id = c.id")
+}
+
+type waitlistEntryModel struct {
+	ID              string         `db:"id"`
+	Status          string         `db:"status"`
+	BikeID          string         `db:"bike_id"`
+	CustomerID      string         `db:"customer_id"`
+	Lat             float64        `db:"lat"`
+	Long            float64        `db:"long"`
+	StartTime       time.Time      `db:"start_time"`
+	EndTime         time.Time      `db:"end_time"`
+	TotalValue      int            `db:"total_value"`
+	AppliedDiscount int            `db:"applied_discount"`
+	DiscountRule    string         `db:"discount_rule"`
+	ReservationID   sql.NullString `db:"reservation_id"`
+	CreatedAt       time.Time      `db:"created_at"`
+
+	// Join on customers
+	FirstName string `db:"first_name"`
+	Surname   string `db:"surname"`
+	Email     string `db:"email"`
+	Type      string `db:"type"`
+}
+
+func newWaitlistEntryModel(e bikerental.WaitlistEntry) waitlistEntryModel {
+	return waitlistEntryModel{
+		ID:              e.ID,
+		Status:          string(e.Status),
+		BikeID:          e.BikeID,
+		CustomerID:      e.Customer.ID,
+		Lat:             e.Location.Lat,
+		Long:            e.Location.Long,
+		StartTime:       e.StartTime,
+		EndTime:         e.EndTime,
+		TotalValue:      e.TotalValue,
+		AppliedDiscount: e.AppliedDiscount,
+		DiscountRule:    e.DiscountRule,
+		ReservationID:   sql.NullString{String: e.ReservationID, Valid: e.ReservationID != ""},
+		CreatedAt:       e.CreatedAt,
+	}
+}
+
+func (m *waitlistEntryModel) ToAppWaitlistEntry() bikerental.WaitlistEntry {
+	cm := customerModel{
+		ID:        m.CustomerID,
+		Type:      m.Type,
+		FirstName: m.FirstName,
+		Surname:   m.Surname,
+		Email:     m.Email,
+	}
+	return bikerental.WaitlistEntry{
+		ID:       m.ID,
+		Status:   bikerental.WaitlistStatus(m.Status),
+		Customer: cm.ToAppCustomer(),
+		BikeID:   m.BikeID,
+		Location: bikerental.Location{
+			Lat:  m.Lat,
+			Long: m.Long,
+		},
+		StartTime:       m.StartTime,
+		EndTime:         m.EndTime,
+		TotalValue:      m.TotalValue,
+		AppliedDiscount: m.AppliedDiscount,
+		DiscountRule:    m.DiscountRule,
+		ReservationID:   m.ReservationID.String,
+		CreatedAt:       m.CreatedAt,
+	}
+}
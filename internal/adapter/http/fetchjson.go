@@ -6,8 +6,19 @@ import (
 	"fmt"
 	"net/http"
 	"time"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/nglogic/go-application-guide/internal/adapter/http")
+
+// propagator writes W3C trace context to outbound request headers.
+var propagator = propagation.TraceContext{}
+
 // Doer is a utility interface. It's implemented by http.Client.
 type Doer interface {
 	Do(*http.Request) (*http.Response, error)
@@ -15,13 +26,20 @@ type Doer interface {
 
 // GetJSON fetches json data using HTTP GET request.
 // Json from response is unmarshalled to the `result` object (it usually should be a pointer!).
+// Request is traced in a client span, and its trace context is propagated in W3C traceparent and tracestate headers.
 func GetJSON(
 	ctx context.Context,
 	doer Doer,
 	timeout time.Duration,
 	url string,
 	result interface{},
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "HTTP GET",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("http.method", http.MethodGet), attribute.String("http.url", url)),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
@@ -29,12 +47,14 @@ func GetJSON(
 	if err != nil {
 		return fmt.Errorf("couldn't create http request: %w", err)
 	}
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
 
 	resp, err := doer.Do(req)
 	if err != nil {
 		return fmt.Errorf("http get '%s': %w", url, err)
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("invalid http status: %d", resp.StatusCode)
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Business metrics label names.
+const (
+	labelStatus  = "status"
+	labelReason  = "reason"
+	labelRule    = "rule"
+	labelSource  = "source"
+	labelService = "service"
+	labelResult  = "result"
+)
+
+// Revenue sources.
+const (
+	revenueSourceRental          = "rental"
+	revenueSourceCancellationFee = "cancellation_fee"
+)
+
+// External lookup results.
+const (
+	lookupResultOK       = "ok"
+	lookupResultNotFound = "not_found"
+	lookupResultError    = "error"
+)
+
+// BusinessMetrics implements bikerental.BusinessMetrics, reporting them with metrics provider.
+type BusinessMetrics struct {
+	provider Provider
+}
+
+// NewBusiness creates new business metrics reported with given provider.
+func NewBusiness(provider Provider) (*BusinessMetrics, error) {
+	if provider == nil {
+		return nil, errors.New("empty metrics provider")
+	}
+	return &BusinessMetrics{
+		provider: provider,
+	}, nil
+}
+
+// ReservationCreated counts reservations by status and discounts by rule.
+func (m *BusinessMetrics) ReservationCreated(r bikerental.Reservation) {
+	m.provider.Count("reservations_created", Labels{labelStatus: string(r.Status)})
+	if r.AppliedDiscount > 0 {
+		m.provider.Add("discount_cents", float64(r.AppliedDiscount), Labels{labelRule: r.DiscountRule})
+	}
+}
+
+// ReservationRejected counts rejected reservation requests by reason.
+func (m *BusinessMetrics) ReservationRejected(reason bikerental.RejectionReason) {
+	m.provider.Count("reservations_rejected", Labels{labelReason: string(reason)})
+}
+
+// ReservationCanceled counts canceled reservations, refunds, and cancellation fees as revenue.
+func (m *BusinessMetrics) ReservationCanceled(c bikerental.Cancellation) {
+	m.provider.Count("reservations_canceled", nil)
+	m.provider.Add("refund_cents", float64(c.Refund), nil)
+	m.provider.Add("revenue_cents", float64(c.Fee), Labels{labelSource: revenueSourceCancellationFee})
+}
+
+// RentalCompleted counts invoiced amount as revenue.
+func (m *BusinessMetrics) RentalCompleted(i bikerental.Invoice) {
+	m.provider.Add("revenue_cents", float64(i.TotalValue), Labels{labelSource: revenueSourceRental})
+}
+
+// ExternalLookup counts lookups by result and observes their durations.
+// Data not found for a location is not a failure.
+func (m *BusinessMetrics) ExternalLookup(service bikerental.ExternalService, duration time.Duration, err error) {
+	result := lookupResultOK
+	switch {
+	case app.IsNotFoundError(err):
+		result = lookupResultNotFound
+	case err != nil:
+		result = lookupResultError
+	}
+
+	labels := Labels{labelService: string(service), labelResult: result}
+	m.provider.Count("external_lookups", labels)
+	m.provider.Duration("external_lookup", duration, labels)
+}
@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+// PrometheusMetrics is a metrics provider backed by Prometheus registry.
+// Counters and duration histograms are registered on first use of the metric name,
+// with label names used in that call.
+type PrometheusMetrics struct {
+	namespace  string
+	registry   *prometheus.Registry
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+	logger     logrus.FieldLogger
+	mu         sync.Mutex
+}
+
+// NewPrometheus returns a new prometheus metrics provider.
+// Metric names are prefixed with namespace.
+// Go runtime and process metrics are registered too.
+func NewPrometheus(namespace string, logger logrus.FieldLogger) (*PrometheusMetrics, error) {
+	if logger == nil {
+		return nil, errors.New("empty logger")
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+
+	return &PrometheusMetrics{
+		namespace:  sanitizeName(namespace),
+		registry:   registry,
+		counters:   make(map[string]*prometheus.CounterVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
+		logger:     logger,
+	}, nil
+}
+
+// Count increments counter `<namespace>_<name>_total`.
+func (pm *PrometheusMetrics) Count(name string, labels Labels) {
+	pm.Add(name, 1, labels)
+}
+
+// Add adds value to counter `<namespace>_<name>_total`. Negative values are ignored.
+func (pm *PrometheusMetrics) Add(name string, value float64, labels Labels) {
+	if value < 0 {
+		pm.logger.Warnf("metrics: adding negative value to %s", name)
+		return
+	}
+
+	pm.mu.Lock()
+	vec, ok := pm.counters[name]
+	if !ok {
+		vec = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: pm.namespace,
+			Name:      sanitizeName(name) + "_total",
+			Help:      "Number of " + name + ".",
+		}, labelNames(labels))
+		pm.counters[name] = vec
+		pm.register(vec)
+	}
+	pm.mu.Unlock()
+
+	c, err := vec.GetMetricWith(prometheus.Labels(labels))
+	if err != nil {
+		pm.logger.Warnf("metrics: counting %s: %v", name, err)
+		return
+	}
+	c.Add(value)
+}
+
+// Duration observes duration in seconds in histogram `<namespace>_<name>_duration_seconds`.
+func (pm *PrometheusMetrics) Duration(name string, duration time.Duration, labels Labels) {
+	pm.mu.Lock()
+	vec, ok := pm.histograms[name]
+	if !ok {
+		vec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: pm.namespace,
+			Name:      sanitizeName(name) + "_duration_seconds",
+			Help:      "Duration of " + name + " in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}, labelNames(labels))
+		pm.histograms[name] = vec
+		pm.register(vec)
+	}
+	pm.mu.Unlock()
+
+	o, err := vec.GetMetricWith(prometheus.Labels(labels))
+	if err != nil {
+		pm.logger.Warnf("metrics: observing %s duration: %v", name, err)
+		return
+	}
+	o.Observe(duration.Seconds())
+}
+
+// Handler returns http handler exposing metrics in Prometheus text format.
+func (pm *PrometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(pm.registry, promhttp.HandlerOpts{})
+}
+
+// register registers collector in the registry.
+// If it fails, e.g. because of invalid label names, the metric is still updated, but not exposed.
+func (pm *PrometheusMetrics) register(c prometheus.Collector) {
+	if err := pm.registry.Register(c); err != nil {
+		pm.logger.Warnf("metrics: registering collector: %v", err)
+	}
+}
+
+func labelNames(labels Labels) []string {
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sanitizeName replaces characters not allowed in Prometheus metric names with underscores.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -8,21 +11,61 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// Common label names.
 const (
-	bufferSize = 50
+	LabelMethod     = "method"
+	LabelStatusCode = "status_code"
+	LabelGRPCCode   = "grpc_code"
 )
 
-// Provider is a dummy metrics example.
+// Labels are metric dimensions, like method or status code.
+// Every metric should be always reported with the same set of label names.
+type Labels map[string]string
+
+// Provider collects app metrics.
 type Provider interface {
-	Count(tags ...string)
-	Duration(duration time.Duration, tags ...string)
+	// Count increments counter with given name.
+	Count(name string, labels Labels)
+
+	// Add adds non negative value to counter with given name.
+	Add(name string, value float64, labels Labels)
+
+	// Duration observes duration of an operation with given name.
+	Duration(name string, duration time.Duration, labels Labels)
+}
+
+// Flusher is implemented by providers buffering metrics, that have to be flushed periodically.
+type Flusher interface {
 	Flush()
 }
 
+// RunFlusher flushes metrics every interval, until context is done.
+// Metrics are flushed for the last time before returning.
+func RunFlusher(ctx context.Context, f Flusher, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.Flush()
+			return
+		case <-ticker.C:
+			f.Flush()
+		}
+	}
+}
+
+type durationStats struct {
+	count int64
+	sum   time.Duration
+}
+
 // DummyMetrics implements some dummy metrics provider.
+// It logs counts and average durations on flush.
 type DummyMetrics struct {
-	Counts    map[string]uint64
-	Durations map[string][]time.Duration
+	Counts    map[string]float64
+	Durations map[string]durationStats
 	logger    logrus.FieldLogger
 	sync.Mutex
 }
@@ -34,48 +77,35 @@ func NewDummy(logger logrus.FieldLogger) *DummyMetrics {
 	}
 }
 
-// Count increases the count for given tags.
-func (dm *DummyMetrics) Count(tags ...string) {
-	if len(tags) == 0 {
-		return
-	}
+// Count increases the count for given name and labels.
+func (dm *DummyMetrics) Count(name string, labels Labels) {
+	dm.Add(name, 1, labels)
+}
 
+// Add increases the count for given name and labels by value.
+func (dm *DummyMetrics) Add(name string, value float64, labels Labels) {
 	dm.Lock()
 	defer dm.Unlock()
 	if dm.Counts == nil {
-		dm.Counts = make(map[string]uint64)
-	}
-
-	key := strings.Join(tags, ".")
-	if _, exists := dm.Counts[key]; !exists {
-		dm.Counts[key] = 0
+		dm.Counts = make(map[string]float64)
 	}
 
-	dm.Counts[key]++
+	dm.Counts[dummyKey(name, labels)] += value
 }
 
-// Duration stores the duration for given tags, will log the average.
-func (dm *DummyMetrics) Duration(duration time.Duration, tags ...string) {
-	if len(tags) == 0 {
-		return
-	}
-
-	if duration == 0 {
-		return
-	}
-
+// Duration stores the duration for given name and labels, will log the average.
+func (dm *DummyMetrics) Duration(name string, duration time.Duration, labels Labels) {
 	dm.Lock()
 	defer dm.Unlock()
 	if dm.Durations == nil {
-		dm.Durations = make(map[string][]time.Duration)
-	}
-
-	key := strings.Join(tags, ".")
-	if _, exists := dm.Durations[key]; !exists {
-		dm.Durations[key] = make([]time.Duration, 0, bufferSize)
+		dm.Durations = make(map[string]durationStats)
 	}
 
-	dm.Durations[key] = append(dm.Durations[key], duration)
+	key := dummyKey(name, labels)
+	stats := dm.Durations[key]
+	stats.count++
+	stats.sum += duration
+	dm.Durations[key] = stats
 }
 
 // Flush flushed the stored data to stdout.
@@ -84,17 +114,27 @@ func (dm *DummyMetrics) Flush() {
 	defer dm.Unlock()
 
 	for key, value := range dm.Counts {
-		dm.logger.Printf("Count: %s - %d\n", key, value)
+		dm.logger.Printf("Count: %s - %g\n", key, value)
 		delete(dm.Counts, key)
 	}
 
-	for key, durations := range dm.Durations {
-		var avg int64
-		for _, d := range durations {
-			avg += d.Nanoseconds()
-		}
-		avg /= int64(len(durations))
-		dm.logger.Printf("Duration: %s - %d\n", key, avg)
+	for key, stats := range dm.Durations {
+		dm.logger.Printf("Duration: %s - %d\n", key, stats.sum.Nanoseconds()/stats.count)
 		delete(dm.Durations, key)
 	}
 }
+
+// dummyKey returns name with labels sorted by label name, like name{a=1,b=2}.
+func dummyKey(name string, labels Labels) string {
+	if len(labels) == 0 {
+		return name
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+)
+
+// Supported span exporters.
+const (
+	// ExporterNone doesn't export spans. Span ids are still generated and propagated to other services.
+	ExporterNone = "none"
+
+	// ExporterStdout writes spans to stdout as json.
+	ExporterStdout = "stdout"
+
+	// ExporterOTLP sends spans to OpenTelemetry collector over grpc.
+	ExporterOTLP = "otlp"
+)
+
+// NewProvider creates new tracer provider with given exporter.
+// otlpEndpoint is a host:port address of the collector, used only by ExporterOTLP.
+// Returned provider should be shut down before the app exits, so buffered spans are exported.
+func NewProvider(ctx context.Context, exporter, otlpEndpoint, serviceName string) (*sdktrace.TracerProvider, error) {
+	if serviceName == "" {
+		return nil, errors.New("empty service name")
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+	}
+
+	switch exporter {
+	case ExporterNone:
+	case ExporterStdout:
+		opts = append(opts, sdktrace.WithBatcher(NewStdoutExporter()))
+	case ExporterOTLP:
+		if otlpEndpoint == "" {
+			return nil, errors.New("empty otlp endpoint")
+		}
+		exp, err := otlptracegrpc.New(ctx,
+			otlptracegrpc.WithEndpoint(otlpEndpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating otlp exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	default:
+		return nil, fmt.Errorf("unknown exporter '%s'", exporter)
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
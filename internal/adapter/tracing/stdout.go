@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// StdoutExporter writes finished spans as json, one span per line.
+type StdoutExporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewStdoutExporter creates new exporter writing to stdout.
+func NewStdoutExporter() *StdoutExporter {
+	return &StdoutExporter{
+		enc: json.NewEncoder(os.Stdout),
+	}
+}
+
+// ExportSpans writes spans.
+func (e *StdoutExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, s := range tracetest.SpanStubsFromReadOnlySpans(spans) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := e.enc.Encode(s); err != nil {
+			return fmt.Errorf("encoding span: %w", err)
+		}
+	}
+	return nil
+}
+
+// Shutdown is a noop, stdout is not closed.
+func (e *StdoutExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
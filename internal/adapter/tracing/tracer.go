@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer implements app.Tracer with OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer creates new app tracer, starting spans with a named tracer from provider.
+func NewTracer(provider trace.TracerProvider, name string) *Tracer {
+	return &Tracer{
+		tracer: provider.Tracer(name),
+	}
+}
+
+// Start starts new span as a child of span from ctx.
+// If ctx has no span, but has remote span context extracted from incoming request, the span continues the caller trace.
+func (t *Tracer) Start(ctx context.Context, name string, attrs ...app.Attribute) (context.Context, app.Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithAttributes(convertAttributes(attrs)...))
+	return ctx, appSpan{span}
+}
+
+// SpanFromContext returns current span from ctx.
+func (t *Tracer) SpanFromContext(ctx context.Context) app.Span {
+	return appSpan{trace.SpanFromContext(ctx)}
+}
+
+// appSpan adapts OpenTelemetry span to app.Span.
+type appSpan struct {
+	span trace.Span
+}
+
+func (s appSpan) SetAttributes(attrs ...app.Attribute) {
+	s.span.SetAttributes(convertAttributes(attrs)...)
+}
+
+func (s appSpan) AddEvent(name string, attrs ...app.Attribute) {
+	s.span.AddEvent(name, trace.WithAttributes(convertAttributes(attrs)...))
+}
+
+func (s appSpan) End() {
+	s.span.End()
+}
+
+func convertAttributes(attrs []app.Attribute) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, attribute.String(a.Key, a.Value))
+	}
+	return kvs
+}
+
+// EndSpan ends the span, marking it as failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
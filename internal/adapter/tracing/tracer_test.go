@@ -0,0 +1,149 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestProvider(t *testing.T) (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	t.Cleanup(func() {
+		_ = tp.Shutdown(context.Background())
+	})
+	return tp, exp
+}
+
+func hasAttribute(attrs []attribute.KeyValue, key, value string) bool {
+	for _, a := range attrs {
+		if string(a.Key) == key && a.Value.AsString() == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTracerStart(t *testing.T) {
+	tp, exp := newTestProvider(t)
+	tracer := NewTracer(tp, "test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent", app.AttrBikeID("bike-1"))
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttributes(app.AttrDiscountRule("rule-1"))
+	child.End()
+	tracer.SpanFromContext(ctx).AddEvent("something happened", app.AttrReservationID("reservation-1"))
+	parent.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("exported %d spans, want 2", len(spans))
+	}
+	gotChild, gotParent := spans[0], spans[1]
+
+	if gotParent.Name != "parent" || gotChild.Name != "child" {
+		t.Fatalf("span names = %q, %q, want \"parent\", \"child\"", gotParent.Name, gotChild.Name)
+	}
+	if gotChild.Parent.SpanID() != gotParent.SpanContext.SpanID() {
+		t.Fatalf("child span parent = %s, want %s", gotChild.Parent.SpanID(), gotParent.SpanContext.SpanID())
+	}
+	if !hasAttribute(gotParent.Attributes, app.AttrKeyBikeID, "bike-1") {
+		t.Fatalf("parent span attributes = %v, want bike id", gotParent.Attributes)
+	}
+	if !hasAttribute(gotChild.Attributes, app.AttrKeyDiscountRule, "rule-1") {
+		t.Fatalf("child span attributes = %v, want discount rule", gotChild.Attributes)
+	}
+	if len(gotParent.Events) != 1 || gotParent.Events[0].Name != "something happened" {
+		t.Fatalf("parent span events = %v, want one event", gotParent.Events)
+	}
+	if !hasAttribute(gotParent.Events[0].Attributes, app.AttrKeyReservationID, "reservation-1") {
+		t.Fatalf("event attributes = %v, want reservation id", gotParent.Events[0].Attributes)
+	}
+}
+
+func TestTracerSpanFromContextWithoutSpan(t *testing.T) {
+	tp, exp := newTestProvider(t)
+	tracer := NewTracer(tp, "test")
+
+	span := tracer.SpanFromContext(context.Background())
+	span.AddEvent("ignored")
+	span.End()
+
+	if spans := exp.GetSpans(); len(spans) != 0 {
+		t.Fatalf("exported %d spans, want 0", len(spans))
+	}
+}
+
+func TestEndSpan(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{
+			name:       "success",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "error",
+			err:        errors.New("boom"),
+			wantStatus: codes.Error,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, exp := newTestProvider(t)
+
+			_, span := tp.Tracer("test").Start(context.Background(), "operation")
+			EndSpan(span, tt.err)
+
+			spans := exp.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("exported %d spans, want 1", len(spans))
+			}
+			if spans[0].Status.Code != tt.wantStatus {
+				t.Fatalf("span status = %v, want %v", spans[0].Status.Code, tt.wantStatus)
+			}
+			if len(spans[0].Events) != tt.wantEvents {
+				t.Fatalf("span has %d events, want %d", len(spans[0].Events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		exporter     string
+		otlpEndpoint string
+		serviceName  string
+		wantErr      bool
+	}{
+		{name: "none", exporter: ExporterNone, serviceName: "test"},
+		{name: "stdout", exporter: ExporterStdout, serviceName: "test"},
+		{name: "empty service name", exporter: ExporterNone, wantErr: true},
+		{name: "otlp without endpoint", exporter: ExporterOTLP, serviceName: "test", wantErr: true},
+		{name: "unknown exporter", exporter: "jaeger", serviceName: "test", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, err := NewProvider(context.Background(), tt.exporter, tt.otlpEndpoint, tt.serviceName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tp != nil {
+				_ = tp.Shutdown(context.Background())
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package bikerental
+
+import (
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// SearchAvailableBikesRequest is a request for searching bikes available for rent in given time range.
+type SearchAvailableBikesRequest struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Location is optional. If set, only bikes at stations within `Radius` are returned.
+	Location *Location
+
+	// Radius in meters. Zero means default radius.
+	Radius float64
+
+	// Optional filters, zero values are ignored.
+	MinWeight       float64
+	MaxWeight       float64
+	MaxPricePerHour int
+
+	// Limit is a max number of returned bikes. Zero means default limit.
+	Limit int
+}
+
+// Validate validates request data.
+func (r *SearchAvailableBikesRequest) Validate() error {
+	if r.StartTime.Before(time.Now()) {
+		return app.NewValidationError("start time can't be in the past")
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+	if r.Location != nil {
+		if err := r.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Radius < 0 {
+		return app.NewValidationError("radius can't be negative")
+	}
+	if r.MinWeight < 0 || r.MaxWeight < 0 || r.MaxPricePerHour < 0 {
+		return app.NewValidationError("filters can't be negative")
+	}
+	return nil
+}
+
+// AvailableBike is a bike available for rent.
+type AvailableBike struct {
+	Bike Bike
+
+	// Distance in meters between bike station and requested location.
+	// It's zero if location wasn't requested.
+	Distance float64
+}
@@ -6,6 +6,17 @@ import (
 	"github.com/nglogic/go-application-guide/internal/app"
 )
 
+// BikeStatus describes operational status of a bike.
+type BikeStatus string
+
+// Bike statuses.
+const (
+	BikeStatusEmpty       BikeStatus = ""
+	BikeStatusAvailable   BikeStatus = "available"
+	BikeStatusMaintenance BikeStatus = "maintenance"
+	BikeStatusRetired     BikeStatus = "retired"
+)
+
 // Bike represents a bike for rent.
 type Bike struct {
 	ID        string
@@ -13,6 +24,14 @@ type Bike struct {
 	Weight    float64
 	// PricePerHour in euro-cents
 	PricePerHour int
+
+	// SerialNumber identifies physical bike unit. It's optional.
+	SerialNumber string
+
+	// StationID is an id of the station where the bike is kept. It's empty if the bike isn't assigned to any station.
+	StationID string
+
+	Status BikeStatus
 }
 
 // Validate validates bike data.
@@ -24,14 +43,42 @@ func (b *Bike) Validate() error {
 		return app.NewValidationError("empty weight")
 	}
 
+	switch b.Status {
+	case BikeStatusAvailable, BikeStatusMaintenance, BikeStatusRetired:
+	default:
+		return app.NewValidationError("invalid bike status")
+	}
+
 	return nil
 }
 
+// InService returns true if the bike can be rented.
+func (b *Bike) InService() bool {
+	return b.Status == BikeStatusAvailable
+}
+
 // BikeService manages bikes.
 type BikeService interface {
-	List(context.Context) ([]Bike, error)
+	List(ctx context.Context, req ListBikesRequest) (*ListBikesResponse, error)
 	Get(ctx context.Context, id string) (*Bike, error)
 	Add(context.Context, Bike) (*Bike, error)
 	Update(ctx context.Context, id string, b Bike) error
 	Delete(ctx context.Context, id string) error
 }
+
+// ListBikesRequest is a request for listing bikes.
+type ListBikesRequest struct {
+	// PageSize limits number of returned bikes. Zero means default size.
+	PageSize int
+
+	// PageToken is a token returned in previous response, used for fetching the next page.
+	PageToken string
+}
+
+// ListBikesResponse is a page of bikes.
+type ListBikesResponse struct {
+	Bikes []Bike
+
+	// NextPageToken is empty if there are no more pages.
+	NextPageToken string
+}
@@ -3,14 +3,47 @@ package bikes
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 )
 
 // Repository can manage bike data.
 type Repository interface {
-	List(context.Context) ([]bikerental.Bike, error)
+	// List returns bikes matching query, sorted by model name and id.
+	List(context.Context, ListBikesQuery) ([]bikerental.Bike, error)
 	Get(ctx context.Context, id string) (*bikerental.Bike, error)
+
+	// Create creates new bike.
+	// Returns app.ValidationError if bike station doesn't exist,
+	// and app.ConflictError if the station is full.
 	Create(context.Context, bikerental.Bike) error
+
+	// Update updates bike by id.
+	// Returns app.ValidationError if bike station doesn't exist,
+	// and app.ConflictError if the station is full.
 	Update(ctx context.Context, id string, b bikerental.Bike) error
+
 	Delete(ctx context.Context, id string) error
 }
+
+// ListBikesQuery is a set of filters for bikes result.
+type ListBikesQuery struct {
+	// After returns only bikes sorted after given cursor. Ignored if empty.
+	After Cursor
+	Limit int
+}
+
+// Cursor is a position of a bike in the list sorted by model name and id.
+type Cursor struct {
+	ModelName string `json:"m"`
+	ID        string `json:"i"`
+}
+
+// Validate checks that cursor decoded from page token points to a valid bike id.
+func (c Cursor) Validate() error {
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return app.NewValidationError("invalid page token")
+	}
+	return nil
+}
@@ -25,13 +25,43 @@ func NewService(bikeRepo Repository) (*Service, error) {
 	}, nil
 }
 
-// List returns all possible bikes.
-func (s *Service) List(ctx context.Context) ([]bikerental.Bike, error) {
-	bs, err := s.repository.List(ctx)
+// List returns a page of bikes sorted by model name.
+func (s *Service) List(ctx context.Context, req bikerental.ListBikesRequest) (*bikerental.ListBikesResponse, error) {
+	pageSize, err := app.PageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after Cursor
+	if req.PageToken != "" {
+		if err := app.DecodePageToken(req.PageToken, &after); err != nil {
+			return nil, err
+		}
+		if err := after.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch one more bike to know if there is a next page.
+	bs, err := s.repository.List(ctx, ListBikesQuery{
+		After: after,
+		Limit: pageSize + 1,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("fetching bikes from repository: %w", err)
 	}
-	return bs, nil
+
+	resp := &bikerental.ListBikesResponse{
+		Bikes: bs,
+	}
+	if len(bs) > pageSize {
+		resp.Bikes = bs[:pageSize]
+		last := resp.Bikes[pageSize-1]
+		resp.NextPageToken, err = app.EncodePageToken(Cursor{ModelName: last.ModelName, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 // Get returns a bike by id.
@@ -44,11 +74,15 @@ func (s *Service) Get(ctx context.Context, id string) (*bikerental.Bike, error)
 }
 
 // Add adds a new bike.
+// Bike is available for rent if status is not set.
 // Returns added bike with new id.
 func (s *Service) Add(ctx context.Context, b bikerental.Bike) (*bikerental.Bike, error) {
 	if b.ID != "" {
 		return nil, app.NewValidationError("can't add new bike with not invalid id")
 	}
+	if b.Status == bikerental.BikeStatusEmpty {
+		b.Status = bikerental.BikeStatusAvailable
+	}
 	if err := b.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid bike data: %w", err)
 	}
@@ -62,13 +96,11 @@ func (s *Service) Add(ctx context.Context, b bikerental.Bike) (*bikerental.Bike,
 }
 
 // Update updates existing bike by id.
+// Bike status is not changed if it's not set.
 func (s *Service) Update(ctx context.Context, id string, b bikerental.Bike) error {
 	if _, err := uuid.Parse(id); err != nil {
 		return app.NewValidationError("invalid id")
 	}
-	if err := b.Validate(); err != nil {
-		return fmt.Errorf("invalid bike data: %w", err)
-	}
 
 	exb, err := s.repository.Get(ctx, id)
 	if err != nil {
@@ -78,6 +110,13 @@ func (s *Service) Update(ctx context.Context, id string, b bikerental.Bike) erro
 		return app.ErrNotFound
 	}
 
+	if b.Status == bikerental.BikeStatusEmpty {
+		b.Status = exb.Status
+	}
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("invalid bike data: %w", err)
+	}
+
 	b.ID = id
 	if err := s.repository.Update(ctx, id, b); err != nil {
 		return fmt.Errorf("updating bike in repository: %w", err)
@@ -0,0 +1,70 @@
+package bikerental
+
+import (
+	"errors"
+	"time"
+)
+
+// CancellationPolicy defines refunds for canceled reservations.
+// Cancellation is free until FreePeriod before reservation start time.
+// Later, FeePercent of the reservation value is charged, and after the start time nothing is refunded.
+type CancellationPolicy struct {
+	FreePeriod time.Duration
+	FeePercent int
+}
+
+// Validate validates policy data.
+func (p CancellationPolicy) Validate() error {
+	if p.FreePeriod < 0 {
+		return errors.New("free cancellation period can't be negative")
+	}
+	if p.FeePercent < 0 || p.FeePercent > 100 {
+		return errors.New("cancellation fee percent has to be between 0 and 100")
+	}
+	return nil
+}
+
+// CancellationPolicies maps customer types to their cancellation policies.
+type CancellationPolicies map[CustomerType]CancellationPolicy
+
+// Cancellation describes the settlement of canceled reservation.
+// Values are in euro-cents, and always sum up to reservation total value.
+type Cancellation struct {
+	ReservationID string
+
+	// Refund is an amount returned to the customer.
+	Refund int
+
+	// Fee is an amount kept as cancellation fee.
+	Fee int
+
+	CanceledAt time.Time
+}
+
+// CancelReservationResponse is a response for cancel reservation request.
+type CancelReservationResponse struct {
+	Cancellation Cancellation
+
+	// Promoted contains reservations created from waitlist entries for the freed time range.
+	Promoted []Reservation
+}
+
+// Cancel calculates refund and fee for reservation canceled at `t`.
+// Pending holds weren't confirmed by the customer, so they are always canceled free of charge.
+func (p CancellationPolicy) Cancel(r Reservation, t time.Time) Cancellation {
+	var fee int
+	switch {
+	case r.Status == ReservationStatusPending:
+	case !t.Before(r.StartTime):
+		fee = r.TotalValue
+	case !t.Before(r.StartTime.Add(-p.FreePeriod)):
+		fee = r.TotalValue * p.FeePercent / 100
+	}
+
+	return Cancellation{
+		ReservationID: r.ID,
+		Refund:        r.TotalValue - fee,
+		Fee:           fee,
+		CanceledAt:    t,
+	}
+}
@@ -0,0 +1,87 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCancellationPolicyCancel(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	policy := CancellationPolicy{FreePeriod: 24 * time.Hour, FeePercent: 30}
+
+	tests := []struct {
+		name       string
+		status     ReservationStatus
+		canceledAt time.Time
+		wantFee    int
+	}{
+		{
+			name:       "before free period ends",
+			status:     ReservationStatusApproved,
+			canceledAt: start.Add(-24*time.Hour - time.Nanosecond),
+		},
+		{
+			name:       "exactly when free period ends",
+			status:     ReservationStatusApproved,
+			canceledAt: start.Add(-24 * time.Hour),
+			wantFee:    1500,
+		},
+		{
+			name:       "just before start time",
+			status:     ReservationStatusApproved,
+			canceledAt: start.Add(-time.Nanosecond),
+			wantFee:    1500,
+		},
+		{
+			name:       "exactly at start time",
+			status:     ReservationStatusApproved,
+			canceledAt: start,
+			wantFee:    5000,
+		},
+		{
+			name:       "after start time",
+			status:     ReservationStatusApproved,
+			canceledAt: start.Add(time.Hour),
+			wantFee:    5000,
+		},
+		{
+			name:       "pending hold within fee period",
+			status:     ReservationStatusPending,
+			canceledAt: start.Add(-time.Hour),
+		},
+		{
+			name:       "pending hold after start time",
+			status:     ReservationStatusPending,
+			canceledAt: start.Add(time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Reservation{ID: "reservation-1", Status: tt.status, StartTime: start, TotalValue: 5000}
+
+			got := policy.Cancel(r, tt.canceledAt)
+
+			if got.Fee != tt.wantFee {
+				t.Fatalf("Fee = %d, want %d", got.Fee, tt.wantFee)
+			}
+			if got.Refund != r.TotalValue-tt.wantFee {
+				t.Fatalf("Refund = %d, want %d", got.Refund, r.TotalValue-tt.wantFee)
+			}
+			if got.ReservationID != r.ID || !got.CanceledAt.Equal(tt.canceledAt) {
+				t.Fatalf("ReservationID, CanceledAt = %q, %s, want %q, %s", got.ReservationID, got.CanceledAt, r.ID, tt.canceledAt)
+			}
+		})
+	}
+}
+
+func TestCancellationPolicyCancelRoundsFeeDown(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	policy := CancellationPolicy{FreePeriod: 24 * time.Hour, FeePercent: 30}
+	r := Reservation{Status: ReservationStatusApproved, StartTime: start, TotalValue: 999}
+
+	// Fee is rounded down, so refund and fee still sum up to the reservation value.
+	got := policy.Cancel(r, start.Add(-time.Hour))
+	if got.Fee != 299 || got.Refund != 700 {
+		t.Fatalf("Fee, Refund = %d, %d, want 299, 700", got.Fee, got.Refund)
+	}
+}
@@ -1,7 +1,9 @@
 package bikerental
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/badoux/checkmail"
 	"github.com/nglogic/go-application-guide/internal/app"
@@ -26,6 +28,12 @@ type Customer struct {
 	Email     string
 }
 
+// NormalizeEmail returns email in the canonical form used for storing and comparing emails.
+// Customers are identified by email case-insensitively.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // Validate validates customer data.
 func (c Customer) Validate() error {
 	switch c.Type {
@@ -51,3 +59,33 @@ func (c Customer) Validate() error {
 
 	return nil
 }
+
+// CustomerService manages customers.
+type CustomerService interface {
+	List(ctx context.Context, req ListCustomersRequest) (*ListCustomersResponse, error)
+	Get(ctx context.Context, id string) (*Customer, error)
+	Add(context.Context, Customer) (*Customer, error)
+	Update(ctx context.Context, id string, c Customer) error
+	Delete(ctx context.Context, id string) error
+}
+
+// ListCustomersRequest is a request for listing customers.
+// All filters are optional.
+type ListCustomersRequest struct {
+	Email string
+	Type  CustomerType
+
+	// PageSize limits number of returned customers. Zero means default size.
+	PageSize int
+
+	// PageToken is a token returned in previous response, used for fetching the next page.
+	PageToken string
+}
+
+// ListCustomersResponse is a page of customers.
+type ListCustomersResponse struct {
+	Customers []Customer
+
+	// NextPageToken is empty if there are no more pages.
+	NextPageToken string
+}
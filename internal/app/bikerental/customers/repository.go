@@ -0,0 +1,56 @@
+package customers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository can manage customer data.
+type Repository interface {
+	// List returns customers matching query, sorted by id.
+	List(context.Context, ListCustomersQuery) ([]bikerental.Customer, error)
+
+	// Get returns customer by id.
+	// Returns app.ErrNotFound if customer doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.Customer, error)
+
+	// Create creates new customer.
+	// Returns app.ConflictError if customer with the same email already exists.
+	Create(context.Context, bikerental.Customer) error
+
+	// Update updates customer by id.
+	// Returns app.ErrNotFound if customer doesn't exist,
+	// and app.ConflictError if other customer with the same email already exists.
+	Update(ctx context.Context, id string, c bikerental.Customer) error
+
+	// Delete deletes customer by id.
+	// Returns app.ErrNotFound if customer doesn't exist,
+	// and app.ConflictError if customer has reservations or waitlist entries.
+	Delete(ctx context.Context, id string) error
+}
+
+// ListCustomersQuery is a set of filters for customers result.
+type ListCustomersQuery struct {
+	Email string
+	Type  bikerental.CustomerType
+
+	// After returns only customers sorted after given cursor. Ignored if empty.
+	After Cursor
+	Limit int
+}
+
+// Cursor is a position of a customer in the list sorted by id.
+type Cursor struct {
+	ID string `json:"i"`
+}
+
+// Validate checks that cursor decoded from page token points to a valid customer id.
+func (c Cursor) Validate() error {
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return app.NewValidationError("invalid page token")
+	}
+	return nil
+}
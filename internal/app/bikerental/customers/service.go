@@ -0,0 +1,131 @@
+package customers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides methods for managing customers.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(customerRepo Repository) (*Service, error) {
+	if customerRepo == nil {
+		return nil, errors.New("empty customer repository")
+	}
+	return &Service{
+		repository: customerRepo,
+	}, nil
+}
+
+// List returns a page of customers matching request filters, sorted by id.
+func (s *Service) List(ctx context.Context, req bikerental.ListCustomersRequest) (*bikerental.ListCustomersResponse, error) {
+	pageSize, err := app.PageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after Cursor
+	if req.PageToken != "" {
+		if err := app.DecodePageToken(req.PageToken, &after); err != nil {
+			return nil, err
+		}
+		if err := after.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fetch one more customer to know if there is a next page.
+	cs, err := s.repository.List(ctx, ListCustomersQuery{
+		Email: req.Email,
+		Type:  req.Type,
+		After: after,
+		Limit: pageSize + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching customers from repository: %w", err)
+	}
+
+	resp := &bikerental.ListCustomersResponse{
+		Customers: cs,
+	}
+	if len(cs) > pageSize {
+		resp.Customers = cs[:pageSize]
+		resp.NextPageToken, err = app.EncodePageToken(Cursor{ID: resp.Customers[pageSize-1].ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Get returns a customer by id.
+func (s *Service) Get(ctx context.Context, id string) (*bikerental.Customer, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, app.NewValidationError("invalid id")
+	}
+
+	c, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("fetching customer from repository: %w", err)
+	}
+	return c, nil
+}
+
+// Add adds a new customer.
+// Returns added customer with new id.
+// Returns app.ConflictError if customer with the same email already exists.
+func (s *Service) Add(ctx context.Context, c bikerental.Customer) (*bikerental.Customer, error) {
+	if c.ID != "" {
+		return nil, app.NewValidationError("can't add new customer with id")
+	}
+	c.Email = bikerental.NormalizeEmail(c.Email)
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid customer data: %w", err)
+	}
+
+	c.ID = uuid.NewString()
+	if err := s.repository.Create(ctx, c); err != nil {
+		return nil, fmt.Errorf("adding customer to repository: %w", err)
+	}
+
+	return &c, nil
+}
+
+// Update updates existing customer by id.
+func (s *Service) Update(ctx context.Context, id string, c bikerental.Customer) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return app.NewValidationError("invalid id")
+	}
+	c.Email = bikerental.NormalizeEmail(c.Email)
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("invalid customer data: %w", err)
+	}
+
+	c.ID = id
+	if err := s.repository.Update(ctx, id, c); err != nil {
+		return fmt.Errorf("updating customer in repository: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes existing customer. If customer doesn't exists, returns nil.
+// Customers with reservations can't be deleted, app.ConflictError is returned in that case.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return app.NewValidationError("invalid id")
+	}
+	if err := s.repository.Delete(ctx, id); err != nil {
+		if app.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("deleting customer in repository: %w", err)
+	}
+	return nil
+}
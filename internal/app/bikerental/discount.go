@@ -3,6 +3,7 @@ package bikerental
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 )
@@ -11,6 +12,13 @@ import (
 type Discount struct {
 	// Amount is in euro-cents.
 	Amount int
+
+	// Rule identifies the discount rule that produced this discount.
+	// It's empty if no discount applies.
+	Rule string
+
+	// Reason is a human-readable explanation of the discount.
+	Reason string
 }
 
 // DiscountService provides methods for calculating discounts for a bike rentals.
@@ -24,7 +32,13 @@ type DiscountRequest struct {
 	Location Location
 	Bike     Bike
 	// ReservationValue in euro-cents.
+	// For group reservations it's a combined value of all bikes.
 	ReservationValue int
+	// GroupSize is a number of bikes rented together. Zero means single bike.
+	GroupSize int
+	// StartTime and EndTime define reservation time range.
+	StartTime time.Time
+	EndTime   time.Time
 }
 
 // Validate validates the request.
@@ -45,10 +59,23 @@ func (r DiscountRequest) Validate() error {
 		return app.NewValidationError("empty bike weight")
 	}
 
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to be after start time")
+	}
+
 	return nil
 }
 
+// Duration returns reservation duration.
+func (r DiscountRequest) Duration() time.Duration {
+	return r.EndTime.Sub(r.StartTime)
+}
+
 // DiscountResponse is a response with calculated discount.
 type DiscountResponse struct {
+	// Discount is the discount that should be applied.
 	Discount Discount
+
+	// Candidates contains all evaluated discount rules, including those that didn't apply.
+	Candidates []Discount
 }
@@ -0,0 +1,85 @@
+package discount
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// combinationStrategy defines how to combine discounts from multiple rules into one.
+type combinationStrategy string
+
+// Combination strategies.
+const (
+	// strategyMax selects only the discount with the greatest value.
+	strategyMax combinationStrategy = "max"
+	// strategyStack sums all discounts.
+	strategyStack combinationStrategy = "stack"
+	// strategyCappedStack sums all discounts, up to a percent of reservation value.
+	strategyCappedStack combinationStrategy = "capped_stack"
+)
+
+// combine chooses discount that should be applied, according to configured strategy.
+// Discount never exceeds reservation value.
+func (c combinationConfig) combine(resValue int, discounts []bikerental.Discount) bikerental.Discount {
+	var result bikerental.Discount
+	switch c.Strategy {
+	case strategyStack:
+		result = stackDiscounts(discounts)
+	case strategyCappedStack:
+		result = stackDiscounts(discounts)
+		if maxAmount := percentOf(resValue, c.MaxPercent); result.Amount > maxAmount {
+			result.Amount = maxAmount
+			result.Reason = fmt.Sprintf("%s; capped at %.0f%% of reservation value", result.Reason, c.MaxPercent)
+		}
+	default:
+		result = selectOptimalDiscount(discounts...)
+	}
+
+	if result.Amount > resValue {
+		result.Amount = resValue
+	}
+	return result
+}
+
+// selectOptimalDiscount chooses one discount that should be applied.
+// Rules:
+// - select discount with the greatest value,
+// - if no discount has positive value, return empty discount.
+func selectOptimalDiscount(discounts ...bikerental.Discount) bikerental.Discount {
+	maxAmount := 0
+	var result bikerental.Discount
+	for _, d := range discounts {
+		if d.Amount > maxAmount {
+			result = d
+			maxAmount = d.Amount
+		}
+	}
+	return result
+}
+
+// stackDiscounts sums all discounts with positive value.
+// Rule ids and reasons of summed discounts are joined together.
+// If no discount has positive value, returns empty discount.
+func stackDiscounts(discounts []bikerental.Discount) bikerental.Discount {
+	var (
+		amount  int
+		rules   []string
+		reasons []string
+	)
+	for _, d := range discounts {
+		if d.Amount <= 0 {
+			continue
+		}
+		amount += d.Amount
+		rules = append(rules, d.Rule)
+		reasons = append(reasons, d.Reason)
+	}
+
+	return bikerental.Discount{
+		Amount: amount,
+		Rule:   strings.Join(rules, "+"),
+		Reason: strings.Join(reasons, "; "),
+	}
+}
@@ -0,0 +1,137 @@
+package discount
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Customer type names used in rules file.
+const (
+	customerTypeIndividual = "individual"
+	customerTypeBusiness   = "business"
+)
+
+// rulesConfig is a content of rules file.
+// It maps customer type names to rule sets for those customers.
+// Customers of types missing in the file don't get any discounts.
+type rulesConfig map[string]ruleSetConfig
+
+type ruleSetConfig struct {
+	Combination combinationConfig `json:"combination"`
+	Rules       []ruleConfig      `json:"rules"`
+}
+
+type combinationConfig struct {
+	Strategy combinationStrategy `json:"strategy"`
+
+	// MaxPercent caps total discount as a percent of reservation value.
+	// Used only by capped stack strategy.
+	MaxPercent float64 `json:"max_percent"`
+}
+
+type ruleConfig struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+
+	// MaxAmount caps discount produced by the rule, in euro-cents.
+	// Zero means no cap.
+	MaxAmount int `json:"max_amount"`
+
+	// Params are rule kind specific parameters.
+	Params json.RawMessage `json:"params"`
+}
+
+// ruleSet is a list of rules used for one customer type, with a way of combining their results.
+type ruleSet struct {
+	rules       []Rule
+	combination combinationConfig
+}
+
+// loadRuleSets reads rules file and creates rule sets for all configured customer types.
+func loadRuleSets(path string, registry *Registry) (map[bikerental.CustomerType]ruleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rules file: %w", err)
+	}
+
+	var conf rulesConfig
+	if err := unmarshalStrict(data, &conf); err != nil {
+		return nil, fmt.Errorf("decoding rules file '%s': %w", path, err)
+	}
+
+	return newRuleSets(conf, registry)
+}
+
+func newRuleSets(conf rulesConfig, registry *Registry) (map[bikerental.CustomerType]ruleSet, error) {
+	result := make(map[bikerental.CustomerType]ruleSet, len(conf))
+	ids := make(map[string]bool)
+	for typeName, setConf := range conf {
+		var ct bikerental.CustomerType
+		switch typeName {
+		case customerTypeIndividual:
+			ct = bikerental.CustomerTypeIndividual
+		case customerTypeBusiness:
+			ct = bikerental.CustomerTypeBusiness
+		default:
+			return nil, fmt.Errorf("unknown customer type '%s'", typeName)
+		}
+
+		if err := setConf.Combination.validate(); err != nil {
+			return nil, fmt.Errorf("invalid combination for '%s' customers: %w", typeName, err)
+		}
+
+		set := ruleSet{
+			combination: setConf.Combination,
+			rules:       make([]Rule, 0, len(setConf.Rules)),
+		}
+		for _, rc := range setConf.Rules {
+			if rc.ID == "" {
+				return nil, fmt.Errorf("rule of kind '%s' for '%s' customers has empty id", rc.Kind, typeName)
+			}
+			if ids[rc.ID] {
+				return nil, fmt.Errorf("duplicated rule id '%s'", rc.ID)
+			}
+			ids[rc.ID] = true
+
+			rule, err := registry.newRule(rc.Kind, rc.ID, rc.Params)
+			if err != nil {
+				return nil, fmt.Errorf("creating rule '%s': %w", rc.ID, err)
+			}
+			if rc.MaxAmount < 0 {
+				return nil, fmt.Errorf("rule '%s' has negative max_amount", rc.ID)
+			}
+			if rc.MaxAmount > 0 {
+				rule = cappedRule{Rule: rule, maxAmount: rc.MaxAmount}
+			}
+			set.rules = append(set.rules, rule)
+		}
+
+		result[ct] = set
+	}
+	return result, nil
+}
+
+func (c combinationConfig) validate() error {
+	switch c.Strategy {
+	case strategyMax, strategyStack:
+	case strategyCappedStack:
+		if c.MaxPercent <= 0 || c.MaxPercent > 100 {
+			return errors.New("max_percent has to be in range (0, 100]")
+		}
+	default:
+		return fmt.Errorf("unknown strategy '%s'", c.Strategy)
+	}
+	return nil
+}
+
+// unmarshalStrict decodes json data, returning error on unknown fields.
+func unmarshalStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
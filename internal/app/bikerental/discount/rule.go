@@ -0,0 +1,108 @@
+package discount
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Rule calculates discount candidate for a bike rental.
+type Rule interface {
+	// ID returns rule identifier, unique within a rules file.
+	ID() string
+
+	// Evaluate returns discount for given input.
+	// If rule doesn't apply, returned discount should have zero amount and a reason why.
+	Evaluate(RuleInput) bikerental.Discount
+}
+
+// RuleInput contains all data rules can use for calculating discounts.
+type RuleInput struct {
+	Request bikerental.DiscountRequest
+
+	// Weather is nil if weather data for rental location is not available.
+	Weather *bikerental.Weather
+
+	// Incidents is nil if incidents data for rental location is not available.
+	Incidents *bikerental.BikeIncidentsInfo
+}
+
+// RuleFactory creates new rule from its configuration parameters.
+type RuleFactory func(id string, params json.RawMessage) (Rule, error)
+
+// Registry maps rule kinds used in rules file to rule factories.
+type Registry struct {
+	factories map[string]RuleFactory
+}
+
+// NewRegistry creates registry with all built-in rule kinds.
+func NewRegistry() *Registry {
+	r := &Registry{
+		factories: make(map[string]RuleFactory),
+	}
+	r.Register(ruleKindBikeWeight, newBikeWeightRule)
+	r.Register(ruleKindTemperature, newTemperatureRule)
+	r.Register(ruleKindIncidents, newIncidentsRule)
+	r.Register(ruleKindReservationValue, newReservationValueRule)
+	r.Register(ruleKindReservationTime, newReservationTimeRule)
+	r.Register(ruleKindGroupSize, newGroupSizeRule)
+	return r
+}
+
+// Register adds rule factory for given kind.
+// Registering the same kind twice replaces previous factory.
+func (r *Registry) Register(kind string, f RuleFactory) {
+	r.factories[kind] = f
+}
+
+// newRule creates rule of given kind.
+func (r *Registry) newRule(kind, id string, params json.RawMessage) (Rule, error) {
+	f, ok := r.factories[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule kind '%s'", kind)
+	}
+	return f(id, params)
+}
+
+// cappedRule limits discount amount returned by wrapped rule.
+type cappedRule struct {
+	Rule
+	maxAmount int
+}
+
+func (r cappedRule) Evaluate(in RuleInput) bikerental.Discount {
+	d := r.Rule.Evaluate(in)
+	if d.Amount > r.maxAmount {
+		d.Amount = r.maxAmount
+		d.Reason = fmt.Sprintf("%s, capped at %d cents", d.Reason, r.maxAmount)
+	}
+	return d
+}
+
+// decodeParams decodes rule parameters into `v`, rejecting unknown fields.
+func decodeParams(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := unmarshalStrict(params, v); err != nil {
+		return fmt.Errorf("decoding rule params: %w", err)
+	}
+	return nil
+}
+
+// percentOf returns `percent` percents of `value`, rounded to full cents.
+func percentOf(value int, percent float64) int {
+	return int(math.Round(
+		(percent / 100.0) * float64(value),
+	))
+}
+
+// noDiscount returns zero discount for a rule, with a reason why the rule didn't apply.
+func noDiscount(rule, reason string) bikerental.Discount {
+	return bikerental.Discount{
+		Rule:   rule,
+		Reason: reason,
+	}
+}
@@ -0,0 +1,258 @@
+package discount
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// TestCalculateDiscountRulesFile checks that rules configured in the shipped rules file
+// give the same discounts as rules that used to be hard-coded in the service.
+func TestCalculateDiscountRulesFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		customerType bikerental.CustomerType
+		value        int
+		duration     time.Duration
+		bikeWeight   float64
+		weather      *bikerental.Weather
+		incidents    *bikerental.BikeIncidentsInfo
+		wantAmount   int
+		wantRule     string
+	}{
+		{
+			name:         "individual, no rule applies",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+		},
+		{
+			name:         "individual, bike weight at threshold",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   15,
+		},
+		{
+			name:         "individual, bike weight 1kg over threshold",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   16,
+			wantAmount:   100,
+			wantRule:     "bike_weight",
+		},
+		{
+			name:         "individual, fractional bike weight",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   25.5,
+			wantAmount:   1050,
+			wantRule:     "bike_weight",
+		},
+		{
+			name:         "individual, bike weight discount capped at 20%",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   40,
+			wantAmount:   2000,
+			wantRule:     "bike_weight",
+		},
+		{
+			name:         "individual, bike weight discount rounded",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        333,
+			duration:     time.Hour,
+			bikeWeight:   16,
+			wantAmount:   3,
+			wantRule:     "bike_weight",
+		},
+		{
+			name:         "individual, temperature below 10C",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			weather:      &bikerental.Weather{Temperature: 9.9},
+			wantAmount:   500,
+			wantRule:     "temperature",
+		},
+		{
+			name:         "individual, temperature at 10C",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			weather:      &bikerental.Weather{Temperature: 10},
+		},
+		{
+			name:         "individual, 2 incidents",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 2},
+		},
+		{
+			name:         "individual, 3 incidents",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 3},
+			wantAmount:   500,
+			wantRule:     "incidents",
+		},
+		{
+			name:         "individual, 5 incidents",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 5},
+			wantAmount:   1000,
+			wantRule:     "incidents",
+		},
+		{
+			name:         "individual, equal discounts, first rule wins",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   20,
+			weather:      &bikerental.Weather{Temperature: 5},
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 4},
+			wantAmount:   500,
+			wantRule:     "bike_weight",
+		},
+		{
+			name:         "individual, greatest discount wins",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   18,
+			weather:      &bikerental.Weather{Temperature: 5},
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 5},
+			wantAmount:   1000,
+			wantRule:     "incidents",
+		},
+		{
+			name:         "individual, 24h reservation",
+			customerType: bikerental.CustomerTypeIndividual,
+			value:        10000,
+			duration:     24 * time.Hour,
+			bikeWeight:   12,
+		},
+		{
+			name:         "business, reservation value below threshold",
+			customerType: bikerental.CustomerTypeBusiness,
+			value:        9999,
+			duration:     time.Hour,
+			bikeWeight:   12,
+		},
+		{
+			name:         "business, reservation value at threshold",
+			customerType: bikerental.CustomerTypeBusiness,
+			value:        10000,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			wantAmount:   500,
+			wantRule:     "business_reservation_value",
+		},
+		{
+			name:         "business, reservation value discount rounded",
+			customerType: bikerental.CustomerTypeBusiness,
+			value:        10010,
+			duration:     time.Hour,
+			bikeWeight:   12,
+			wantAmount:   501,
+			wantRule:     "business_reservation_value",
+		},
+		{
+			name:         "business, reservation time wins over value",
+			customerType: bikerental.CustomerTypeBusiness,
+			value:        10000,
+			duration:     24 * time.Hour,
+			bikeWeight:   12,
+			wantAmount:   1500,
+			wantRule:     "business_reservation_time",
+		},
+		{
+			name:         "business, individual rules don't apply",
+			customerType: bikerental.CustomerTypeBusiness,
+			value:        5000,
+			duration:     time.Hour,
+			bikeWeight:   40,
+			weather:      &bikerental.Weather{Temperature: 5},
+			incidents:    &bikerental.BikeIncidentsInfo{NumberOfIncidents: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, tt.weather, tt.incidents)
+			r := newTestRequest(tt.customerType, tt.value, tt.duration)
+			r.Bike.Weight = tt.bikeWeight
+
+			resp, err := s.CalculateDiscount(context.Background(), r)
+			if err != nil {
+				t.Fatalf("CalculateDiscount() unexpected error = %v", err)
+			}
+			if resp.Discount.Amount != tt.wantAmount || resp.Discount.Rule != tt.wantRule {
+				t.Fatalf("CalculateDiscount() discount = %d (%q), want %d (%q)",
+					resp.Discount.Amount, resp.Discount.Rule, tt.wantAmount, tt.wantRule)
+			}
+		})
+	}
+}
+
+type fixedRule struct {
+	id     string
+	amount int
+}
+
+func (r fixedRule) ID() string {
+	return r.id
+}
+
+func (r fixedRule) Evaluate(RuleInput) bikerental.Discount {
+	return bikerental.Discount{Amount: r.amount, Rule: r.id, Reason: "fixed discount"}
+}
+
+func TestCalculateDiscountCustomRuleKind(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("fixed", func(id string, params json.RawMessage) (Rule, error) {
+		var p struct {
+			Amount int `json:"amount"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return fixedRule{id: id, amount: p.Amount}, nil
+	})
+
+	path := filepath.Join(t.TempDir(), "rules.json")
+	rules := `{"business": {"combination": {"strategy": "max"}, "rules": [{"id": "flat", "kind": "fixed", "params": {"amount": 123}}]}}`
+	if err := os.WriteFile(path, []byte(rules), 0o600); err != nil {
+		t.Fatalf("writing rules file: %v", err)
+	}
+
+	s, err := NewService(weatherStub{}, incidentsStub{}, noopBusinessMetrics{}, noopTracer{}, registry, path)
+	if err != nil {
+		t.Fatalf("creating discount service: %v", err)
+	}
+
+	resp, err := s.CalculateDiscount(context.Background(), newTestRequest(bikerental.CustomerTypeBusiness, 5000, time.Hour))
+	if err != nil {
+		t.Fatalf("CalculateDiscount() unexpected error = %v", err)
+	}
+	if resp.Discount.Amount != 123 || resp.Discount.Rule != "flat" {
+		t.Fatalf("CalculateDiscount() discount = %d (%q), want 123 (\"flat\")", resp.Discount.Amount, resp.Discount.Rule)
+	}
+}
@@ -1,28 +1,146 @@
 package discount
 
-// This file contains all business rules for calculating discounts.
+// This file contains business rules for calculating discounts, mostly used for business customers.
 
 import (
-	"math"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 )
 
-// newBusinessCustomerDiscount returns discounts for business customers.
+// Rule kinds based on reservation attributes.
+const (
+	ruleKindReservationValue = "reservation_value"
+	ruleKindReservationTime  = "reservation_time"
+	ruleKindGroupSize        = "group_size"
+)
+
+// reservationValueRule returns discount based on reservation value.
+// Discount rules:
+// - minimum reservation value: `MinValue` cents
+// - discount value: `Percent` of reservation value.
+type reservationValueRule struct {
+	id     string
+	params struct {
+		MinValue int     `json:"min_value"`
+		Percent  float64 `json:"percent"`
+	}
+}
+
+func newReservationValueRule(id string, params json.RawMessage) (Rule, error) {
+	r := &reservationValueRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
+	}
+	if r.params.Percent <= 0 || r.params.Percent > 100 {
+		return nil, errors.New("percent has to be in range (0, 100]")
+	}
+	return r, nil
+}
+
+func (r *reservationValueRule) ID() string {
+	return r.id
+}
+
+func (r *reservationValueRule) Evaluate(in RuleInput) bikerental.Discount {
+	resValue := in.Request.ReservationValue
+	if resValue < r.params.MinValue {
+		return noDiscount(r.id, fmt.Sprintf("reservation value %d cents is below %d cents", resValue, r.params.MinValue))
+	}
+	return bikerental.Discount{
+		Amount: percentOf(resValue, r.params.Percent),
+		Rule:   r.id,
+		Reason: fmt.Sprintf("%.0f%% off for reservation value %d cents", r.params.Percent, resValue),
+	}
+}
+
+// reservationTimeRule returns discount based on reservation duration.
+// Discount rules:
+// - minimum reservation time: `MinDuration`
+// - discount value: `Percent` of reservation value.
+type reservationTimeRule struct {
+	id          string
+	minDuration time.Duration
+	params      struct {
+		// MinDuration is in time.ParseDuration format, e.g. "24h".
+		MinDuration string  `json:"min_duration"`
+		Percent     float64 `json:"percent"`
+	}
+}
+
+func newReservationTimeRule(id string, params json.RawMessage) (Rule, error) {
+	r := &reservationTimeRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
+	}
+	d, err := time.ParseDuration(r.params.MinDuration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid min_duration: %w", err)
+	}
+	r.minDuration = d
+	if r.params.Percent <= 0 || r.params.Percent > 100 {
+		return nil, errors.New("percent has to be in range (0, 100]")
+	}
+	return r, nil
+}
+
+func (r *reservationTimeRule) ID() string {
+	return r.id
+}
+
+func (r *reservationTimeRule) Evaluate(in RuleInput) bikerental.Discount {
+	resDuration := in.Request.Duration()
+	if resDuration < r.minDuration {
+		return noDiscount(r.id, fmt.Sprintf("reservation time %s is below %s", resDuration, r.minDuration))
+	}
+	return bikerental.Discount{
+		Amount: percentOf(in.Request.ReservationValue, r.params.Percent),
+		Rule:   r.id,
+		Reason: fmt.Sprintf("%.0f%% off for reservation time %s", r.params.Percent, resDuration),
+	}
+}
+
+// groupSizeRule returns discount for renting multiple bikes together.
 // Discount rules:
-// - business customers only
-// - minimum reservation value: 100 (10000 cents)
-// - discount value: 5% of reservation value.
-func newBusinessCustomerDiscount(resValue int, customer bikerental.Customer) bikerental.Discount {
-	if customer.Type != bikerental.CustomerTypeBusiness {
-		return bikerental.Discount{}
+// - minimum number of bikes: `MinBikes`
+// - discount value: `Percent` of combined reservation value.
+type groupSizeRule struct {
+	id     string
+	params struct {
+		MinBikes int     `json:"min_bikes"`
+		Percent  float64 `json:"percent"`
+	}
+}
+
+func newGroupSizeRule(id string, params json.RawMessage) (Rule, error) {
+	r := &groupSizeRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
+	}
+	if r.params.MinBikes < 2 {
+		return nil, errors.New("min_bikes has to be at least 2")
 	}
-	if resValue < 10000 {
-		return bikerental.Discount{}
+	if r.params.Percent <= 0 || r.params.Percent > 100 {
+		return nil, errors.New("percent has to be in range (0, 100]")
+	}
+	return r, nil
+}
+
+func (r *groupSizeRule) ID() string {
+	return r.id
+}
+
+func (r *groupSizeRule) Evaluate(in RuleInput) bikerental.Discount {
+	size := in.Request.GroupSize
+	if size < r.params.MinBikes {
+		return noDiscount(r.id, fmt.Sprintf("group of %d bikes is smaller than %d bikes", size, r.params.MinBikes))
 	}
 	return bikerental.Discount{
-		Amount: int(math.Round(
-			0.05 * float64(resValue),
-		)),
+		Amount: percentOf(in.Request.ReservationValue, r.params.Percent),
+		Rule:   r.id,
+		Reason: fmt.Sprintf("%.0f%% off for group of %d bikes", r.params.Percent, size),
 	}
 }
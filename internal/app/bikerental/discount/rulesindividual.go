@@ -1,92 +1,175 @@
 package discount
 
-// This file contains all business rules for calculating discounts.
+// This file contains business rules for calculating discounts, mostly used for individual customers.
 
 import (
-	"math"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 )
 
-// newBikeWeightDiscount returns discount for individual customers based on reservation value and bike weight.
+// Rule kinds based on bike and rental location conditions.
+const (
+	ruleKindBikeWeight  = "bike_weight"
+	ruleKindTemperature = "temperature"
+	ruleKindIncidents   = "incidents"
+)
+
+// bikeWeightRule returns discount based on reservation value and bike weight.
 // Discount rules:
-// - individual customers only
-// - bike weight >= 15kg
-// - maximum discount is 20% of reservation value.
-func newBikeWeightDiscount(resValue int, customer bikerental.Customer, bike bikerental.Bike) bikerental.Discount {
-	if customer.Type != bikerental.CustomerTypeIndividual {
-		return bikerental.Discount{}
+// - bike weight >= `MinWeight`
+// - `PercentPerKg` discount for each kg above `MinWeight`
+// - maximum discount is `MaxPercent` of reservation value.
+type bikeWeightRule struct {
+	id     string
+	params struct {
+		MinWeight    float64 `json:"min_weight"`
+		PercentPerKg float64 `json:"percent_per_kg"`
+		MaxPercent   float64 `json:"max_percent"`
+	}
+}
+
+func newBikeWeightRule(id string, params json.RawMessage) (Rule, error) {
+	r := &bikeWeightRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
 	}
-	if bike.Weight < 15 {
-		return bikerental.Discount{}
+	if r.params.PercentPerKg <= 0 {
+		return nil, errors.New("percent_per_kg has to be positive")
 	}
+	if r.params.MaxPercent <= 0 || r.params.MaxPercent > 100 {
+		return nil, errors.New("max_percent has to be in range (0, 100]")
+	}
+	return r, nil
+}
+
+func (r *bikeWeightRule) ID() string {
+	return r.id
+}
 
-	discountPercent := bike.Weight - 15.0
-	if discountPercent > 20 {
-		discountPercent = 20
+func (r *bikeWeightRule) Evaluate(in RuleInput) bikerental.Discount {
+	bike := in.Request.Bike
+	if bike.Weight < r.params.MinWeight {
+		return noDiscount(r.id, fmt.Sprintf("bike weight %.1fkg is below %.1fkg", bike.Weight, r.params.MinWeight))
+	}
+
+	discountPercent := (bike.Weight - r.params.MinWeight) * r.params.PercentPerKg
+	if discountPercent > r.params.MaxPercent {
+		discountPercent = r.params.MaxPercent
 	}
 
 	return bikerental.Discount{
-		Amount: int(math.Round(
-			(discountPercent / 100.0) * float64(resValue)),
-		),
+		Amount: percentOf(in.Request.ReservationValue, discountPercent),
+		Rule:   r.id,
+		Reason: fmt.Sprintf("%.1f%% off for bike weight %.1fkg", discountPercent, bike.Weight),
 	}
 }
 
-// newTemperatureDiscount creates discount based on weather.
+// temperatureRule creates discount based on weather.
 // Discount rules:
-// - individual customers only
-// - low outside temperature.
-func newTemperatureDiscount(resValue int, customer bikerental.Customer, weather *bikerental.Weather) bikerental.Discount {
-	if customer.Type != bikerental.CustomerTypeIndividual {
-		return bikerental.Discount{}
+// - outside temperature below `BelowTemperature`
+// - discount value: `Percent` of reservation value.
+type temperatureRule struct {
+	id     string
+	params struct {
+		BelowTemperature float64 `json:"below_temperature"`
+		Percent          float64 `json:"percent"`
+	}
+}
+
+func newTemperatureRule(id string, params json.RawMessage) (Rule, error) {
+	r := &temperatureRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
 	}
-	if weather == nil || weather.Temperature >= 10 {
-		return bikerental.Discount{}
+	if r.params.Percent <= 0 || r.params.Percent > 100 {
+		return nil, errors.New("percent has to be in range (0, 100]")
+	}
+	return r, nil
+}
+
+func (r *temperatureRule) ID() string {
+	return r.id
+}
+
+func (r *temperatureRule) Evaluate(in RuleInput) bikerental.Discount {
+	if in.Weather == nil {
+		return noDiscount(r.id, "no weather data for rental location")
+	}
+	if in.Weather.Temperature >= r.params.BelowTemperature {
+		return noDiscount(r.id, fmt.Sprintf("temperature %.1fC is not below %.1fC", in.Weather.Temperature, r.params.BelowTemperature))
 	}
 
 	return bikerental.Discount{
-		Amount: int(math.Round(float64(resValue) * 0.05)),
+		Amount: percentOf(in.Request.ReservationValue, r.params.Percent),
+		Rule:   r.id,
+		Reason: fmt.Sprintf("%.0f%% off for temperature %.1fC", r.params.Percent, in.Weather.Temperature),
 	}
 }
 
-// newIncidentsDiscount creates discount based on incidents in the neighborhood.
+// incidentsRule creates discount based on incidents in the neighborhood.
 // Discount rules:
-// - individual customers only
-// - incidents in neighborhood present.
-func newIncidentsDiscount(resValue int, customer bikerental.Customer, incidents *bikerental.BikeIncidentsInfo) bikerental.Discount {
-	if customer.Type != bikerental.CustomerTypeIndividual {
-		return bikerental.Discount{}
+// - number of incidents in neighborhood reaches at least one of `Tiers`
+// - discount value: `Percent` of the highest reached tier.
+type incidentsRule struct {
+	id     string
+	params struct {
+		Tiers []incidentsTier `json:"tiers"`
 	}
+}
 
-	if incidents == nil || incidents.NumberOfIncidents < 3 {
-		return bikerental.Discount{}
-	}
+type incidentsTier struct {
+	MinIncidents int     `json:"min_incidents"`
+	Percent      float64 `json:"percent"`
+}
 
-	discountPercent := 0.0
-	if incidents.NumberOfIncidents >= 5 {
-		discountPercent += 10.0
-	} else {
-		discountPercent += 5.0
+func newIncidentsRule(id string, params json.RawMessage) (Rule, error) {
+	r := &incidentsRule{id: id}
+	if err := decodeParams(params, &r.params); err != nil {
+		return nil, err
 	}
-	return bikerental.Discount{
-		Amount: int(math.Round(
-			float64(resValue) * (discountPercent / 100.0),
-		)),
+	if len(r.params.Tiers) == 0 {
+		return nil, errors.New("at least one tier is required")
+	}
+	for _, t := range r.params.Tiers {
+		if t.MinIncidents <= 0 {
+			return nil, errors.New("tier min_incidents has to be positive")
+		}
+		if t.Percent <= 0 || t.Percent > 100 {
+			return nil, errors.New("tier percent has to be in range (0, 100]")
+		}
 	}
+
+	// Highest tier first, so the first matching tier is the best one.
+	sort.Slice(r.params.Tiers, func(i, j int) bool {
+		return r.params.Tiers[i].MinIncidents > r.params.Tiers[j].MinIncidents
+	})
+	return r, nil
+}
+
+func (r *incidentsRule) ID() string {
+	return r.id
 }
 
-// selectOptimalDiscount chooses one discount that should be applied.
-// Rules:
-// - select discount with the greatest value.
-func selectOptimalDiscount(discounts ...bikerental.Discount) bikerental.Discount {
-	maxAmount := -math.MaxInt64
-	var result bikerental.Discount
-	for _, d := range discounts {
-		if d.Amount > maxAmount {
-			result = d
-			maxAmount = d.Amount
+func (r *incidentsRule) Evaluate(in RuleInput) bikerental.Discount {
+	if in.Incidents == nil {
+		return noDiscount(r.id, "no incidents data for rental location")
+	}
+
+	n := in.Incidents.NumberOfIncidents
+	for _, t := range r.params.Tiers {
+		if n >= t.MinIncidents {
+			return bikerental.Discount{
+				Amount: percentOf(in.Request.ReservationValue, t.Percent),
+				Rule:   r.id,
+				Reason: fmt.Sprintf("%.0f%% off for %d incidents near rental location", t.Percent, n),
+			}
 		}
 	}
-	return result
+
+	lowest := r.params.Tiers[len(r.params.Tiers)-1]
+	return noDiscount(r.id, fmt.Sprintf("%d incidents near rental location, at least %d required", n, lowest.MinIncidents))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
@@ -18,12 +19,22 @@ const (
 type Service struct {
 	weatherService   bikerental.WeatherService
 	incidentsService bikerental.BikeIncidentsService
+	businessMetrics  bikerental.BusinessMetrics
+	tracer           app.Tracer
+	ruleSets         map[bikerental.CustomerType]ruleSet
 }
 
 // NewService creates new service instance.
+// Discount rules are loaded from json file at `rulesFile` path.
+// Rule kinds used in the file are created with factories from `registry`,
+// so custom rule kinds can be added with Registry.Register.
 func NewService(
 	weather bikerental.WeatherService,
 	incidents bikerental.BikeIncidentsService,
+	businessMetrics bikerental.BusinessMetrics,
+	tracer app.Tracer,
+	registry *Registry,
+	rulesFile string,
 ) (*Service, error) {
 	if weather == nil {
 		return nil, errors.New("empty weather service")
@@ -31,22 +42,47 @@ func NewService(
 	if incidents == nil {
 		return nil, errors.New("empty incidents service")
 	}
+	if businessMetrics == nil {
+		return nil, errors.New("empty business metrics")
+	}
+	if tracer == nil {
+		return nil, errors.New("empty tracer")
+	}
+	if registry == nil {
+		return nil, errors.New("empty rules registry")
+	}
+	if rulesFile == "" {
+		return nil, errors.New("empty rules file path")
+	}
+
+	ruleSets, err := loadRuleSets(rulesFile, registry)
+	if err != nil {
+		return nil, fmt.Errorf("loading discount rules: %w", err)
+	}
 
 	return &Service{
 		weatherService:   weather,
 		incidentsService: incidents,
+		businessMetrics:  businessMetrics,
+		tracer:           tracer,
+		ruleSets:         ruleSets,
 	}, nil
 }
 
 // CalculateDiscount returns available discount for a bike rental.
 func (s *Service) CalculateDiscount(ctx context.Context, r bikerental.DiscountRequest) (*bikerental.DiscountResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "discount.Service.CalculateDiscount", app.AttrBikeID(r.Bike.ID))
+	defer span.End()
+
 	if err := r.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
+	start := time.Now()
 	weather, err := s.weatherService.GetWeather(ctx, bikerental.WeatherRequest{
 		Location: r.Location,
 	})
+	s.businessMetrics.ExternalLookup(bikerental.ExternalServiceWeather, time.Since(start), err)
 	if err != nil {
 		// We're ok with nil weather value if weather for given location is not found.
 		if app.IsNotFoundError(err) {
@@ -56,10 +92,12 @@ func (s *Service) CalculateDiscount(ctx context.Context, r bikerental.DiscountRe
 		}
 	}
 
+	start = time.Now()
 	incidents, err := s.incidentsService.GetIncidents(ctx, bikerental.BikeIncidentsRequest{
 		Location:  r.Location,
 		Proximity: incidentsProximity,
 	})
+	s.businessMetrics.ExternalLookup(bikerental.ExternalServiceIncidents, time.Since(start), err)
 	if err != nil {
 		// We're ok with nil incidents info if data for given location is not found.
 		if app.IsNotFoundError(err) {
@@ -69,14 +107,26 @@ func (s *Service) CalculateDiscount(ctx context.Context, r bikerental.DiscountRe
 		}
 	}
 
-	discount := selectOptimalDiscount(
-		newBikeWeightDiscount(r.ReservationValue, r.Customer, r.Bike),
-		newTemperatureDiscount(r.ReservationValue, r.Customer, weather),
-		newIncidentsDiscount(r.ReservationValue, r.Customer, incidents),
-		newBusinessCustomerDiscount(r.ReservationValue, r.Customer),
-	)
+	set, ok := s.ruleSets[r.Customer.Type]
+	if !ok {
+		return &bikerental.DiscountResponse{}, nil
+	}
+
+	in := RuleInput{
+		Request:   r,
+		Weather:   weather,
+		Incidents: incidents,
+	}
+	candidates := make([]bikerental.Discount, 0, len(set.rules))
+	for _, rule := range set.rules {
+		candidates = append(candidates, rule.Evaluate(in))
+	}
+
+	discount := set.combination.combine(r.ReservationValue, candidates)
+	span.SetAttributes(app.AttrDiscountRule(discount.Rule))
 
 	return &bikerental.DiscountResponse{
-		Discount: discount,
+		Discount:   discount,
+		Candidates: candidates,
 	}, nil
 }
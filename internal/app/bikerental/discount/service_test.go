@@ -0,0 +1,143 @@
+package discount
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// rulesFile is the rules file shipped with the app.
+const rulesFile = "../../../../configs/discount/rules.json"
+
+type weatherStub struct {
+	weather *bikerental.Weather
+}
+
+func (s weatherStub) GetWeather(context.Context, bikerental.WeatherRequest) (*bikerental.Weather, error) {
+	if s.weather == nil {
+		return nil, app.ErrNotFound
+	}
+	return s.weather, nil
+}
+
+type incidentsStub struct {
+	incidents *bikerental.BikeIncidentsInfo
+}
+
+func (s incidentsStub) GetIncidents(context.Context, bikerental.BikeIncidentsRequest) (*bikerental.BikeIncidentsInfo, error) {
+	if s.incidents == nil {
+		return nil, app.ErrNotFound
+	}
+	return s.incidents, nil
+}
+
+type noopBusinessMetrics struct{}
+
+func (noopBusinessMetrics) ReservationCreated(bikerental.Reservation)                       {}
+func (noopBusinessMetrics) ReservationRejected(bikerental.RejectionReason)                  {}
+func (noopBusinessMetrics) ReservationCanceled(bikerental.Cancellation)                     {}
+func (noopBusinessMetrics) RentalCompleted(bikerental.Invoice)                              {}
+func (noopBusinessMetrics) ExternalLookup(bikerental.ExternalService, time.Duration, error) {}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string, _ ...app.Attribute) (context.Context, app.Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) SpanFromContext(context.Context) app.Span {
+	return noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...app.Attribute)    {}
+func (noopSpan) AddEvent(string, ...app.Attribute) {}
+func (noopSpan) End()                              {}
+
+func newTestService(t *testing.T, weather *bikerental.Weather, incidents *bikerental.BikeIncidentsInfo) *Service {
+	t.Helper()
+
+	s, err := NewService(weatherStub{weather}, incidentsStub{incidents}, noopBusinessMetrics{}, noopTracer{}, NewRegistry(), rulesFile)
+	if err != nil {
+		t.Fatalf("creating discount service: %v", err)
+	}
+	return s
+}
+
+func newTestRequest(customerType bikerental.CustomerType, value int, duration time.Duration) bikerental.DiscountRequest {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	return bikerental.DiscountRequest{
+		Customer: bikerental.Customer{
+			Type:      customerType,
+			FirstName: "John",
+			Email:     "john@example.com",
+		},
+		Location:         bikerental.Location{Lat: 52.23, Long: 21.01},
+		Bike:             bikerental.Bike{ID: "bike", Weight: 12},
+		ReservationValue: value,
+		StartTime:        start,
+		EndTime:          start.Add(duration),
+	}
+}
+
+func TestCalculateDiscountBusinessReservationTime(t *testing.T) {
+	tests := []struct {
+		name         string
+		customerType bikerental.CustomerType
+		duration     time.Duration
+		wantAmount   int
+		wantRule     string
+	}{
+		{
+			name:         "business customer, just under 24h",
+			customerType: bikerental.CustomerTypeBusiness,
+			duration:     24*time.Hour - time.Second,
+		},
+		{
+			name:         "business customer, exactly 24h",
+			customerType: bikerental.CustomerTypeBusiness,
+			duration:     24 * time.Hour,
+			wantAmount:   750,
+			wantRule:     "business_reservation_time",
+		},
+		{
+			name:         "business customer, over 24h",
+			customerType: bikerental.CustomerTypeBusiness,
+			duration:     36 * time.Hour,
+			wantAmount:   750,
+			wantRule:     "business_reservation_time",
+		},
+		{
+			name:         "individual customer, over 24h",
+			customerType: bikerental.CustomerTypeIndividual,
+			duration:     36 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, nil, nil)
+
+			resp, err := s.CalculateDiscount(context.Background(), newTestRequest(tt.customerType, 5000, tt.duration))
+			if err != nil {
+				t.Fatalf("CalculateDiscount() unexpected error = %v", err)
+			}
+			if resp.Discount.Amount != tt.wantAmount || resp.Discount.Rule != tt.wantRule {
+				t.Fatalf("CalculateDiscount() discount = %d (%q), want %d (%q)",
+					resp.Discount.Amount, resp.Discount.Rule, tt.wantAmount, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestCalculateDiscountEndTimeBeforeStartTime(t *testing.T) {
+	s := newTestService(t, nil, nil)
+
+	_, err := s.CalculateDiscount(context.Background(), newTestRequest(bikerental.CustomerTypeBusiness, 5000, -time.Hour))
+	if !app.IsValidationError(err) {
+		t.Fatalf("CalculateDiscount() error = %v, want validation error", err)
+	}
+}
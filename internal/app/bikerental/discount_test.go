@@ -0,0 +1,95 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func TestDiscountRequestValidate(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	valid := DiscountRequest{
+		Customer: Customer{
+			Type:      CustomerTypeBusiness,
+			FirstName: "John",
+			Email:     "john@example.com",
+		},
+		Location:         Location{Lat: 52.23, Long: 21.01},
+		Bike:             Bike{Weight: 12},
+		ReservationValue: 5000,
+		StartTime:        start,
+		EndTime:          start.Add(24 * time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *DiscountRequest)
+		wantErr bool
+	}{
+		{
+			name:   "valid business customer request",
+			modify: func(r *DiscountRequest) {},
+		},
+		{
+			name:   "valid individual customer request",
+			modify: func(r *DiscountRequest) { r.Customer.Type = CustomerTypeIndividual },
+		},
+		{
+			name:    "unknown customer type",
+			modify:  func(r *DiscountRequest) { r.Customer.Type = CustomerTypeUnknown },
+			wantErr: true,
+		},
+		{
+			name:    "end time before start time",
+			modify:  func(r *DiscountRequest) { r.EndTime = r.StartTime.Add(-time.Hour) },
+			wantErr: true,
+		},
+		{
+			name:    "end time equal to start time",
+			modify:  func(r *DiscountRequest) { r.EndTime = r.StartTime },
+			wantErr: true,
+		},
+		{
+			name:    "empty reservation value",
+			modify:  func(r *DiscountRequest) { r.ReservationValue = 0 },
+			wantErr: true,
+		},
+		{
+			name:    "empty location",
+			modify:  func(r *DiscountRequest) { r.Location = Location{} },
+			wantErr: true,
+		},
+		{
+			name:    "empty bike weight",
+			modify:  func(r *DiscountRequest) { r.Bike.Weight = 0 },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid
+			tt.modify(&r)
+
+			err := r.Validate()
+			if tt.wantErr {
+				if !app.IsValidationError(err) {
+					t.Fatalf("Validate() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() unexpected error = %v", err)
+			}
+		})
+	}
+}
+
+func TestDiscountRequestDuration(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	r := DiscountRequest{StartTime: start, EndTime: start.Add(25 * time.Hour)}
+
+	if got := r.Duration(); got != 25*time.Hour {
+		t.Fatalf("Duration() = %s, want %s", got, 25*time.Hour)
+	}
+}
@@ -0,0 +1,81 @@
+package bikerental
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// MaxGroupSize limits number of bikes in one group reservation.
+const MaxGroupSize = 20
+
+// CreateGroupReservationRequest is a request for reserving multiple bikes for one customer and time range.
+type CreateGroupReservationRequest struct {
+	BikeIDs   []string
+	Customer  Customer
+	Location  Location
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Validate validates request data.
+func (r *CreateGroupReservationRequest) Validate() error {
+	if len(r.BikeIDs) < 2 {
+		return app.NewValidationError("group reservation needs at least 2 bikes")
+	}
+	if len(r.BikeIDs) > MaxGroupSize {
+		return app.NewValidationError(fmt.Sprintf("group reservation can't have more than %d bikes", MaxGroupSize))
+	}
+	ids := make(map[string]bool, len(r.BikeIDs))
+	for _, id := range r.BikeIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return app.NewValidationError(fmt.Sprintf("bike id '%s' is invalid", id))
+		}
+		if ids[id] {
+			return app.NewValidationError(fmt.Sprintf("bike id '%s' is duplicated", id))
+		}
+		ids[id] = true
+	}
+	if r.Customer.ID == "" {
+		if err := r.Customer.Validate(); err != nil {
+			return fmt.Errorf("invalid customer data: %w", err)
+		}
+	}
+	if err := r.Location.Validate(); err != nil {
+		return fmt.Errorf("invalid location data: %w", err)
+	}
+
+	if r.StartTime.Before(time.Now()) {
+		return app.NewValidationError("start time can't be in the past")
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+
+	return nil
+}
+
+// GroupReservationResponse is a response for create group reservation request.
+// If status is other than "approved", no reservation was created.
+type GroupReservationResponse struct {
+	Status ReservationStatus
+
+	// Reason contains reason of responding with given status.
+	// If status is "approved", it should be empty.
+	Reason string
+
+	// GroupID identifies created reservations. It's empty for statuses other than "approved".
+	GroupID string
+
+	// Reservations will be empty for statuses other than "approved".
+	Reservations []Reservation
+
+	// Discount is applied to the combined value of all reservations.
+	Discount Discount
+
+	// UnavailableBikeIDs contains bikes already reserved in requested time range.
+	UnavailableBikeIDs []string
+}
@@ -0,0 +1,49 @@
+package bikerental
+
+import (
+	"math"
+	"time"
+)
+
+// Invoice is a final bill for a completed rental.
+// Values are in euro-cents.
+type Invoice struct {
+	ReservationID string
+
+	// ReservationValue is a value of the reservation for scheduled time, before discount.
+	ReservationValue int
+
+	// Discount applied when reservation was created.
+	Discount int
+
+	// LateFee is a penalty for returning the bike after reservation end time.
+	LateFee int
+
+	// LateHours is a number of started hours between reservation end time and actual return time.
+	LateHours int
+
+	// TotalValue is a final amount to pay by the customer.
+	TotalValue int
+
+	CreatedAt time.Time
+}
+
+// NewInvoice calculates invoice for a reservation returned at `returnedAt`.
+// Late fee is charged for every started hour after reservation end time.
+func NewInvoice(r Reservation, returnedAt time.Time, lateFeePerHour int) Invoice {
+	var lateHours int
+	if late := returnedAt.Sub(r.EndTime); late > 0 {
+		lateHours = int(math.Ceil(late.Hours()))
+	}
+	lateFee := lateHours * lateFeePerHour
+
+	return Invoice{
+		ReservationID:    r.ID,
+		ReservationValue: r.TotalValue + r.AppliedDiscount,
+		Discount:         r.AppliedDiscount,
+		LateFee:          lateFee,
+		LateHours:        lateHours,
+		TotalValue:       r.TotalValue + lateFee,
+		CreatedAt:        returnedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package bikerental
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewInvoice(t *testing.T) {
+	end := time.Date(2021, 6, 1, 18, 0, 0, 0, time.UTC)
+	r := Reservation{
+		ID:              "reservation-1",
+		EndTime:         end,
+		TotalValue:      4500,
+		AppliedDiscount: 500,
+	}
+	const lateFeePerHour = 300
+
+	tests := []struct {
+		name          string
+		returnedAt    time.Time
+		wantLateHours int
+		wantTotal     int
+	}{
+		{
+			name:       "returned before end time",
+			returnedAt: end.Add(-time.Hour),
+			wantTotal:  4500,
+		},
+		{
+			name:       "returned exactly at end time",
+			returnedAt: end,
+			wantTotal:  4500,
+		},
+		{
+			name:          "one nanosecond late",
+			returnedAt:    end.Add(time.Nanosecond),
+			wantLateHours: 1,
+			wantTotal:     4800,
+		},
+		{
+			name:          "exactly one hour late",
+			returnedAt:    end.Add(time.Hour),
+			wantLateHours: 1,
+			wantTotal:     4800,
+		},
+		{
+			name:          "just over one hour late",
+			returnedAt:    end.Add(time.Hour + time.Second),
+			wantLateHours: 2,
+			wantTotal:     5100,
+		},
+		{
+			name:          "exactly three hours late",
+			returnedAt:    end.Add(3 * time.Hour),
+			wantLateHours: 3,
+			wantTotal:     5400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewInvoice(r, tt.returnedAt, lateFeePerHour)
+
+			if got.LateHours != tt.wantLateHours {
+				t.Fatalf("LateHours = %d, want %d", got.LateHours, tt.wantLateHours)
+			}
+			if got.LateFee != tt.wantLateHours*lateFeePerHour {
+				t.Fatalf("LateFee = %d, want %d", got.LateFee, tt.wantLateHours*lateFeePerHour)
+			}
+			if got.TotalValue != tt.wantTotal {
+				t.Fatalf("TotalValue = %d, want %d", got.TotalValue, tt.wantTotal)
+			}
+			if got.ReservationValue != 5000 || got.Discount != 500 {
+				t.Fatalf("ReservationValue, Discount = %d, %d, want 5000, 500", got.ReservationValue, got.Discount)
+			}
+			if got.ReservationID != r.ID || !got.CreatedAt.Equal(tt.returnedAt) {
+				t.Fatalf("ReservationID, CreatedAt = %q, %s, want %q, %s", got.ReservationID, got.CreatedAt, r.ID, tt.returnedAt)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package bikerental
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/nglogic/go-application-guide/internal/app"
 )
@@ -24,3 +25,17 @@ func (l *Location) Validate() error {
 func (l *Location) String() string {
 	return fmt.Sprintf("lat:%f long:%f", l.Lat, l.Long)
 }
+
+// earthRadius is a mean Earth radius in meters.
+const earthRadius = 6371000
+
+// DistanceTo returns great-circle distance to other location in meters.
+func (l *Location) DistanceTo(o Location) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(o.Lat - l.Lat)
+	dLong := toRad(o.Long - l.Long)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(l.Lat))*math.Cos(toRad(o.Lat))*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
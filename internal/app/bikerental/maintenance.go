@@ -0,0 +1,53 @@
+package bikerental
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// MaintenanceWindow is a time range when the bike is out of service and can't be rented.
+type MaintenanceWindow struct {
+	ID        string
+	BikeID    string
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    string
+}
+
+// Validate validates maintenance window data.
+func (w *MaintenanceWindow) Validate() error {
+	if _, err := uuid.Parse(w.BikeID); err != nil {
+		return app.NewValidationError("bike id is invalid")
+	}
+	if !w.EndTime.After(w.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+	if !w.EndTime.After(time.Now()) {
+		return app.NewValidationError("end time can't be in the past")
+	}
+	return nil
+}
+
+// ScheduleMaintenanceResponse is a response for scheduling maintenance.
+// If maintenance overlaps with reservations, it's not scheduled, and `Window` is nil.
+type ScheduleMaintenanceResponse struct {
+	Window *MaintenanceWindow
+
+	// Conflicts contains reservations overlapping with requested maintenance window.
+	Conflicts []Reservation
+}
+
+// MaintenanceService manages bike maintenance windows.
+type MaintenanceService interface {
+	Schedule(context.Context, MaintenanceWindow) (*ScheduleMaintenanceResponse, error)
+
+	// List returns maintenance windows of a bike overlapping with given time range.
+	List(ctx context.Context, bikeID string, startTime, endTime time.Time) ([]MaintenanceWindow, error)
+
+	// Cancel deletes maintenance window, and returns reservations promoted from waitlist to the freed time range.
+	Cancel(ctx context.Context, bikeID string, id string) (promoted []Reservation, err error)
+}
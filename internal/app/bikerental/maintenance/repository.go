@@ -0,0 +1,28 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository can manage maintenance windows data.
+type Repository interface {
+	// List returns maintenance windows of a bike overlapping with given time range, sorted by start time.
+	List(ctx context.Context, bikeID string, startTime, endTime time.Time) ([]bikerental.MaintenanceWindow, error)
+
+	// Get returns maintenance window by id.
+	// Returns app.ErrNotFound if window doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.MaintenanceWindow, error)
+
+	// Create creates maintenance window, if there are no bike reservations overlapping with it.
+	// Otherwise window is not created, and overlapping reservations are returned.
+	Create(context.Context, bikerental.MaintenanceWindow) ([]bikerental.Reservation, error)
+
+	// Delete deletes maintenance window by id.
+	// In the same transaction, freed time range is offered to the oldest fitting waitlist entry,
+	// like when a reservation is released.
+	// Returns promoted reservations, and app.ErrNotFound if window doesn't exist.
+	Delete(ctx context.Context, id string) (promoted []bikerental.Reservation, err error)
+}
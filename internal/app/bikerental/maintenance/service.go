@@ -0,0 +1,112 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides methods for managing bike maintenance windows.
+type Service struct {
+	bikeService     bikerental.BikeService
+	repository      Repository
+	businessMetrics bikerental.BusinessMetrics
+}
+
+// NewService creates new service instance.
+func NewService(bikeService bikerental.BikeService, maintenanceRepo Repository, businessMetrics bikerental.BusinessMetrics) (*Service, error) {
+	if bikeService == nil {
+		return nil, errors.New("empty bike service")
+	}
+	if maintenanceRepo == nil {
+		return nil, errors.New("empty maintenance repository")
+	}
+	if businessMetrics == nil {
+		return nil, errors.New("empty business metrics")
+	}
+	return &Service{
+		bikeService:     bikeService,
+		repository:      maintenanceRepo,
+		businessMetrics: businessMetrics,
+	}, nil
+}
+
+// Schedule creates new maintenance window for a bike.
+// If the window overlaps with bike reservations, it's not created, and response contains the reservations.
+func (s *Service) Schedule(ctx context.Context, w bikerental.MaintenanceWindow) (*bikerental.ScheduleMaintenanceResponse, error) {
+	if w.ID != "" {
+		return nil, app.NewValidationError("can't schedule maintenance with id")
+	}
+	if err := w.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
+	// Check if bike exists.
+	if _, err := s.bikeService.Get(ctx, w.BikeID); err != nil {
+		return nil, fmt.Errorf("fetching bike data: %w", err)
+	}
+
+	w.ID = uuid.NewString()
+	conflicts, err := s.repository.Create(ctx, w)
+	if err != nil {
+		return nil, fmt.Errorf("creating maintenance window in repository: %w", err)
+	}
+	if len(conflicts) > 0 {
+		return &bikerental.ScheduleMaintenanceResponse{
+			Conflicts: conflicts,
+		}, nil
+	}
+
+	return &bikerental.ScheduleMaintenanceResponse{
+		Window: &w,
+	}, nil
+}
+
+// List returns maintenance windows of a bike overlapping with given time range.
+// Zero start or end time leaves the range open on that side.
+func (s *Service) List(ctx context.Context, bikeID string, startTime, endTime time.Time) ([]bikerental.MaintenanceWindow, error) {
+	if _, err := uuid.Parse(bikeID); err != nil {
+		return nil, app.NewValidationError("bike id is invalid")
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+		return nil, app.NewValidationError("end time have to ba after start time")
+	}
+
+	ws, err := s.repository.List(ctx, bikeID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("fetching maintenance windows from repository: %w", err)
+	}
+	return ws, nil
+}
+
+// Cancel deletes maintenance window by id and bike id.
+// Freed time range is offered to the oldest fitting waitlist entry.
+// Returns reservations promoted from waitlist, and app.ErrNotFound if maintenance window doesn't exist.
+func (s *Service) Cancel(ctx context.Context, bikeID string, id string) ([]bikerental.Reservation, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, app.NewValidationError("invalid id")
+	}
+
+	w, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("fetching maintenance window from repository: %w", err)
+	}
+	// If the bike id doesn't match it's basically the same as invalid id.
+	if w.BikeID != bikeID {
+		return nil, app.ErrNotFound
+	}
+
+	promoted, err := s.repository.Delete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("deleting maintenance window in repository: %w", err)
+	}
+	for _, r := range promoted {
+		s.businessMetrics.ReservationCreated(r)
+	}
+	return promoted, nil
+}
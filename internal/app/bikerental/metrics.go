@@ -0,0 +1,43 @@
+package bikerental
+
+import "time"
+
+// RejectionReason is a category of rejected reservation request, reported in business metrics.
+type RejectionReason string
+
+// Possible rejection reasons.
+const (
+	RejectionBikeNotFound     RejectionReason = "bike_not_found"
+	RejectionBikeNotRentable  RejectionReason = "bike_not_rentable"
+	RejectionBikeNotAvailable RejectionReason = "bike_not_available"
+	RejectionPromoCode        RejectionReason = "promo_code"
+)
+
+// ExternalService identifies external data source in business metrics.
+type ExternalService string
+
+// External data sources used for calculating discounts.
+const (
+	ExternalServiceWeather   ExternalService = "weather"
+	ExternalServiceIncidents ExternalService = "incidents"
+)
+
+// BusinessMetrics collects domain metrics of bike rental.
+// All amounts are in euro-cents.
+type BusinessMetrics interface {
+	// ReservationCreated reports approved reservation with its value and applied discount.
+	// Pending holds are reported only when they are confirmed.
+	ReservationCreated(Reservation)
+
+	// ReservationRejected reports reservation request rejected for given reason.
+	ReservationRejected(RejectionReason)
+
+	// ReservationCanceled reports canceled reservation with its refund and cancellation fee.
+	ReservationCanceled(Cancellation)
+
+	// RentalCompleted reports revenue from completed rental.
+	RentalCompleted(Invoice)
+
+	// ExternalLookup reports duration and result of fetching data from external service.
+	ExternalLookup(service ExternalService, duration time.Duration, err error)
+}
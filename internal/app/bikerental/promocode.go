@@ -0,0 +1,144 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// DiscountRulePromoCode identifies discounts coming from promo codes.
+const DiscountRulePromoCode = "promo_code"
+
+// ErrPromoCodeNotRedeemable is returned when promo code can't be used for a reservation,
+// for example when its redemption limits were reached.
+var ErrPromoCodeNotRedeemable = app.NewConflictError("promo code can't be redeemed")
+
+var promoCodeFormat = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// PromoCode represents a voucher customers can use to get a discount.
+// Exactly one of Percent or Amount should be set.
+type PromoCode struct {
+	Code string
+
+	// Percent of reservation value to discount.
+	Percent float64
+
+	// Amount is a fixed discount in euro-cents.
+	Amount int
+
+	// ValidFrom and ValidTo define time window in which the code can be redeemed.
+	ValidFrom time.Time
+	ValidTo   time.Time
+
+	// MaxRedemptions limits total number of redemptions. Zero means no limit.
+	MaxRedemptions int
+
+	// MaxRedemptionsPerCustomer limits number of redemptions by one customer. Zero means no limit.
+	MaxRedemptionsPerCustomer int
+
+	// Stackable codes are added on top of a discount calculated from discount rules.
+	// Not stackable codes compete with it, and the greater one is applied.
+	Stackable bool
+
+	Disabled bool
+
+	// Redemptions is a number of times the code was redeemed.
+	Redemptions int
+}
+
+// NormalizePromoCode returns code in the canonical form used for storing and comparing codes.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate validates promo code data.
+func (p PromoCode) Validate() error {
+	if !promoCodeFormat.MatchString(p.Code) {
+		return app.NewValidationError("code has to have 3-32 characters: letters, digits, '-' or '_'")
+	}
+
+	switch {
+	case p.Percent != 0 && p.Amount != 0:
+		return app.NewValidationError("only one of percent or amount can be set")
+	case p.Percent == 0 && p.Amount == 0:
+		return app.NewValidationError("percent or amount is required")
+	case p.Percent < 0 || p.Percent > 100:
+		return app.NewValidationError("percent has to be in range (0, 100]")
+	case p.Amount < 0:
+		return app.NewValidationError("amount can't be negative")
+	}
+
+	if !p.ValidTo.After(p.ValidFrom) {
+		return app.NewValidationError("valid to time has to be after valid from time")
+	}
+	if p.MaxRedemptions < 0 || p.MaxRedemptionsPerCustomer < 0 {
+		return app.NewValidationError("redemption limits can't be negative")
+	}
+
+	return nil
+}
+
+// CheckActive returns ValidationError if the code can't be used at time `t`.
+func (p PromoCode) CheckActive(t time.Time) error {
+	if p.Disabled {
+		return app.NewValidationError("promo code is disabled")
+	}
+	if t.Before(p.ValidFrom) {
+		return app.NewValidationError(fmt.Sprintf("promo code is valid from %s", p.ValidFrom.Format(time.RFC3339)))
+	}
+	if !t.Before(p.ValidTo) {
+		return app.NewValidationError("promo code has expired")
+	}
+	return nil
+}
+
+// Apply combines discount calculated from discount rules with the promo code discount.
+// Returns false if promo code discount wasn't used, because discount `d` is better.
+// Resulting discount never exceeds reservation value.
+func (p PromoCode) Apply(resValue int, d Discount) (Discount, bool) {
+	var amount int
+	var reason string
+	if p.Percent != 0 {
+		amount = int(math.Round(float64(resValue) * p.Percent / 100.0))
+		reason = fmt.Sprintf("%.0f%% off with promo code %s", p.Percent, p.Code)
+	} else {
+		amount = p.Amount
+		reason = fmt.Sprintf("%d cents off with promo code %s", p.Amount, p.Code)
+	}
+
+	var result Discount
+	switch {
+	case p.Stackable && d.Amount > 0:
+		result = Discount{
+			Amount: d.Amount + amount,
+			Rule:   d.Rule + "+" + DiscountRulePromoCode,
+			Reason: d.Reason + "; " + reason,
+		}
+	case d.Amount >= amount:
+		return d, false
+	default:
+		result = Discount{
+			Amount: amount,
+			Rule:   DiscountRulePromoCode,
+			Reason: reason,
+		}
+	}
+
+	if result.Amount > resValue {
+		result.Amount = resValue
+	}
+	return result, true
+}
+
+// PromoCodeService manages promo codes.
+type PromoCodeService interface {
+	List(context.Context) ([]PromoCode, error)
+	Get(ctx context.Context, code string) (*PromoCode, error)
+	Create(context.Context, PromoCode) (*PromoCode, error)
+	Disable(ctx context.Context, code string) error
+}
@@ -0,0 +1,25 @@
+package promocodes
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository can manage promo codes data.
+type Repository interface {
+	// List returns all promo codes.
+	List(context.Context) ([]bikerental.PromoCode, error)
+
+	// Get returns promo code.
+	// Returns app.ErrNotFound if code doesn't exist.
+	Get(ctx context.Context, code string) (*bikerental.PromoCode, error)
+
+	// Create creates new promo code.
+	// Returns app.ConflictError if code already exists.
+	Create(context.Context, bikerental.PromoCode) error
+
+	// Disable marks promo code as disabled.
+	// Returns app.ErrNotFound if code doesn't exist.
+	Disable(ctx context.Context, code string) error
+}
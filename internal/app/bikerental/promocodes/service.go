@@ -0,0 +1,77 @@
+package promocodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides methods for managing promo codes.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(repo Repository) (*Service, error) {
+	if repo == nil {
+		return nil, errors.New("empty promo codes repository")
+	}
+	return &Service{
+		repository: repo,
+	}, nil
+}
+
+// List returns all promo codes.
+func (s *Service) List(ctx context.Context) ([]bikerental.PromoCode, error) {
+	pcs, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching promo codes from repository: %w", err)
+	}
+	return pcs, nil
+}
+
+// Get returns promo code.
+func (s *Service) Get(ctx context.Context, code string) (*bikerental.PromoCode, error) {
+	code = bikerental.NormalizePromoCode(code)
+	if code == "" {
+		return nil, app.NewValidationError("empty code")
+	}
+
+	pc, err := s.repository.Get(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("fetching promo code from repository: %w", err)
+	}
+	return pc, nil
+}
+
+// Create adds new promo code.
+// Returns app.ConflictError if code already exists.
+func (s *Service) Create(ctx context.Context, pc bikerental.PromoCode) (*bikerental.PromoCode, error) {
+	pc.Code = bikerental.NormalizePromoCode(pc.Code)
+	pc.Disabled = false
+	pc.Redemptions = 0
+	if err := pc.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid promo code data: %w", err)
+	}
+
+	if err := s.repository.Create(ctx, pc); err != nil {
+		return nil, fmt.Errorf("adding promo code to repository: %w", err)
+	}
+	return &pc, nil
+}
+
+// Disable disables promo code, so it can't be redeemed anymore.
+func (s *Service) Disable(ctx context.Context, code string) error {
+	code = bikerental.NormalizePromoCode(code)
+	if code == "" {
+		return app.NewValidationError("empty code")
+	}
+
+	if err := s.repository.Disable(ctx, code); err != nil {
+		return fmt.Errorf("disabling promo code in repository: %w", err)
+	}
+	return nil
+}
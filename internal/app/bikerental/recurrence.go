@@ -0,0 +1,246 @@
+package bikerental
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// Limits of recurring reservations.
+const (
+	// MaxRecurrenceOccurrences limits number of reservations created from one recurring request.
+	MaxRecurrenceOccurrences = 100
+
+	// MaxRecurrencePeriod limits time between the first and the last occurrence.
+	MaxRecurrencePeriod = 366 * 24 * time.Hour
+)
+
+// RecurrenceFrequency describes how often a reservation repeats.
+type RecurrenceFrequency string
+
+// Recurrence frequencies.
+const (
+	RecurrenceDaily  RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly RecurrenceFrequency = "WEEKLY"
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is a subset of iCalendar RRULE, for example "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+// Supported parts are FREQ (DAILY or WEEKLY), INTERVAL and BYDAY (only with WEEKLY frequency).
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+
+	// Interval is a number of days or weeks between occurrences.
+	Interval int
+
+	// Weekdays are used only with weekly frequency.
+	// If empty, reservation repeats on the weekday of the first occurrence.
+	Weekdays []time.Weekday
+}
+
+// ParseRecurrenceRule parses RRULE-like string.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	rule := RecurrenceRule{Interval: 1}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	for _, part := range strings.Split(s, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return RecurrenceRule{}, app.NewValidationError(fmt.Sprintf("invalid recurrence rule part '%s'", part))
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		switch key {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(value)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil {
+				return RecurrenceRule{}, app.NewValidationError("recurrence interval has to be a number")
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				wd, ok := rruleWeekdays[d]
+				if !ok {
+					return RecurrenceRule{}, app.NewValidationError(fmt.Sprintf("invalid recurrence weekday '%s'", d))
+				}
+				rule.Weekdays = append(rule.Weekdays, wd)
+			}
+		default:
+			return RecurrenceRule{}, app.NewValidationError(fmt.Sprintf("unsupported recurrence rule part '%s'", key))
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	return rule, nil
+}
+
+// Validate validates recurrence rule.
+func (r RecurrenceRule) Validate() error {
+	switch r.Frequency {
+	case RecurrenceDaily:
+		if len(r.Weekdays) > 0 {
+			return app.NewValidationError("weekdays can be used only with weekly recurrence")
+		}
+	case RecurrenceWeekly:
+	default:
+		return app.NewValidationError("recurrence frequency has to be DAILY or WEEKLY")
+	}
+	if r.Interval < 1 {
+		return app.NewValidationError("recurrence interval has to be positive")
+	}
+	return nil
+}
+
+// Occurrences expands the rule into time ranges.
+// Time of day and duration are taken from the first occurrence `start`-`end`, and are kept in location `loc`,
+// so occurrences don't move with daylight saving time changes.
+// Occurrences start on days matching the rule, from `start` day until `until` time.
+// Returns ValidationError if there are more than MaxRecurrenceOccurrences occurrences.
+func (r RecurrenceRule) Occurrences(start, end, until time.Time, loc *time.Location) ([]Occurrence, error) {
+	start, end = start.In(loc), end.In(loc)
+	endDays := int(time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+
+	weekdays := make(map[time.Weekday]bool)
+	for _, wd := range r.Weekdays {
+		weekdays[wd] = true
+	}
+	if len(weekdays) == 0 {
+		weekdays[start.Weekday()] = true
+	}
+	// Weeks start on Monday, like in RRULE by default.
+	firstWeekDay := (int(start.Weekday()) + 6) % 7
+
+	var result []Occurrence
+	for day := 0; ; day++ {
+		occStart := time.Date(start.Year(), start.Month(), start.Day()+day, start.Hour(), start.Minute(), start.Second(), 0, loc)
+		if occStart.After(until) {
+			break
+		}
+
+		switch r.Frequency {
+		case RecurrenceDaily:
+			if day%r.Interval != 0 {
+				continue
+			}
+		case RecurrenceWeekly:
+			week := (firstWeekDay + day) / 7
+			if week%r.Interval != 0 || !weekdays[occStart.Weekday()] {
+				continue
+			}
+		}
+
+		occ := Occurrence{
+			StartTime: occStart,
+			EndTime:   time.Date(start.Year(), start.Month(), start.Day()+day+endDays, end.Hour(), end.Minute(), end.Second(), 0, loc),
+		}
+		if len(result) > 0 && occ.StartTime.Before(result[len(result)-1].EndTime) {
+			return nil, app.NewValidationError("recurring reservation occurrences overlap")
+		}
+		if len(result) == MaxRecurrenceOccurrences {
+			return nil, app.NewValidationError(fmt.Sprintf("recurring reservation can't have more than %d occurrences", MaxRecurrenceOccurrences))
+		}
+		result = append(result, occ)
+	}
+	return result, nil
+}
+
+// Occurrence is a time range of one reservation from recurring reservation.
+type Occurrence struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// CreateRecurringReservationRequest is a request for creating a series of reservations.
+type CreateRecurringReservationRequest struct {
+	BikeID   string
+	Customer Customer
+	Location Location
+
+	// StartTime and EndTime define the first occurrence.
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Rule is an RRULE-like recurrence pattern, see RecurrenceRule.
+	Rule string
+
+	// Until is the latest start time of an occurrence.
+	Until time.Time
+
+	// TimeZone is an IANA time zone name, used for keeping occurrences at the same local time. Default is UTC.
+	TimeZone string
+}
+
+// Validate validates request data.
+func (r *CreateRecurringReservationRequest) Validate() error {
+	if _, err := uuid.Parse(r.BikeID); err != nil {
+		return app.NewValidationError("bike id is invalid")
+	}
+	if r.Customer.ID == "" {
+		if err := r.Customer.Validate(); err != nil {
+			return fmt.Errorf("invalid customer data: %w", err)
+		}
+	}
+	if err := r.Location.Validate(); err != nil {
+		return fmt.Errorf("invalid location data: %w", err)
+	}
+
+	if r.StartTime.Before(time.Now()) {
+		return app.NewValidationError("start time can't be in the past")
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+	if r.Until.Before(r.StartTime) {
+		return app.NewValidationError("until time can't be before start time")
+	}
+	if r.Until.Sub(r.StartTime) > MaxRecurrencePeriod {
+		return app.NewValidationError("recurring reservation can't last longer than a year")
+	}
+
+	return nil
+}
+
+// RecurringReservationResponse is a response for create recurring reservation request.
+// If status is other than "approved", no reservation was created.
+type RecurringReservationResponse struct {
+	Status ReservationStatus
+
+	// Reason contains reason of responding with given status.
+	// If status is "approved", it should be empty.
+	Reason string
+
+	// SeriesID identifies created reservations. It's empty for statuses other than "approved".
+	SeriesID string
+
+	// Reservations will be empty for statuses other than "approved".
+	Reservations []Reservation
+
+	// Conflicts contains occurrences for which the bike is not available.
+	Conflicts []Occurrence
+}
+
+// CancelReservationSeriesResponse is a response for cancel reservation series request.
+type CancelReservationSeriesResponse struct {
+	// Cancellations contains refunds and fees settled for each canceled reservation.
+	Cancellations []Cancellation
+
+	// Promoted contains reservations created from waitlist entries for the freed time ranges.
+	Promoted []Reservation
+}
@@ -0,0 +1,288 @@
+package bikerental
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	// Embedded time zone database, so tests don't depend on zoneinfo installed in the system.
+	_ "time/tzdata"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    RecurrenceRule
+		wantErr bool
+	}{
+		{
+			name: "daily",
+			rule: "FREQ=DAILY",
+			want: RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+		},
+		{
+			name: "weekly with interval and weekdays",
+			rule: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			want: RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Friday}},
+		},
+		{
+			name: "lowercase with spaces around",
+			rule: " freq=weekly;byday=su ",
+			want: RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1, Weekdays: []time.Weekday{time.Sunday}},
+		},
+		{
+			name:    "empty rule",
+			rule:    "",
+			wantErr: true,
+		},
+		{
+			name:    "part without value",
+			rule:    "FREQ",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported frequency",
+			rule:    "FREQ=MONTHLY",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported part",
+			rule:    "FREQ=DAILY;COUNT=3",
+			wantErr: true,
+		},
+		{
+			name:    "interval is not a number",
+			rule:    "FREQ=DAILY;INTERVAL=x",
+			wantErr: true,
+		},
+		{
+			name:    "zero interval",
+			rule:    "FREQ=DAILY;INTERVAL=0",
+			wantErr: true,
+		},
+		{
+			name:    "invalid weekday",
+			rule:    "FREQ=WEEKLY;BYDAY=XX",
+			wantErr: true,
+		},
+		{
+			name:    "weekdays with daily frequency",
+			rule:    "FREQ=DAILY;BYDAY=MO",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRecurrenceRule(tt.rule)
+			if tt.wantErr {
+				if !app.IsValidationError(err) {
+					t.Fatalf("ParseRecurrenceRule() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseRecurrenceRule() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleOccurrences(t *testing.T) {
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Fatalf("loading location: %v", err)
+	}
+	utc := func(day, month, hour int) time.Time {
+		return time.Date(2021, time.Month(month), day, hour, 0, 0, 0, time.UTC)
+	}
+	local := func(day, month, hour int) time.Time {
+		return time.Date(2021, time.Month(month), day, hour, 0, 0, 0, warsaw)
+	}
+
+	tests := []struct {
+		name    string
+		rule    RecurrenceRule
+		start   time.Time
+		end     time.Time
+		until   time.Time
+		loc     *time.Location
+		want    []Occurrence
+		wantErr bool
+	}{
+		{
+			name:  "daily with interval, until equal to the last start",
+			rule:  RecurrenceRule{Frequency: RecurrenceDaily, Interval: 2},
+			start: utc(7, 6, 10),
+			end:   utc(7, 6, 12),
+			until: utc(13, 6, 10),
+			loc:   time.UTC,
+			want: []Occurrence{
+				{StartTime: utc(7, 6, 10), EndTime: utc(7, 6, 12)},
+				{StartTime: utc(9, 6, 10), EndTime: utc(9, 6, 12)},
+				{StartTime: utc(11, 6, 10), EndTime: utc(11, 6, 12)},
+				{StartTime: utc(13, 6, 10), EndTime: utc(13, 6, 12)},
+			},
+		},
+		{
+			name:  "until before the first start",
+			rule:  RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+			start: utc(7, 6, 10),
+			end:   utc(7, 6, 12),
+			until: utc(7, 6, 9),
+			loc:   time.UTC,
+		},
+		{
+			// Clocks in Warsaw move forward on 28 March 2021, local time stays at 9:00.
+			name:  "daily keeps local time over DST start",
+			rule:  RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+			start: utc(26, 3, 8),
+			end:   utc(26, 3, 9),
+			until: local(29, 3, 9),
+			loc:   warsaw,
+			want: []Occurrence{
+				{StartTime: local(26, 3, 9), EndTime: local(26, 3, 10)},
+				{StartTime: local(27, 3, 9), EndTime: local(27, 3, 10)},
+				{StartTime: local(28, 3, 9), EndTime: local(28, 3, 10)},
+				{StartTime: local(29, 3, 9), EndTime: local(29, 3, 10)},
+			},
+		},
+		{
+			// Clocks in Warsaw move back on 31 October 2021, local time stays at 18:00.
+			name:  "weekly keeps local time over DST end",
+			rule:  RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1},
+			start: local(27, 10, 18),
+			end:   local(27, 10, 19),
+			until: local(3, 11, 18),
+			loc:   warsaw,
+			want: []Occurrence{
+				{StartTime: local(27, 10, 18), EndTime: local(27, 10, 19)},
+				{StartTime: local(3, 11, 18), EndTime: local(3, 11, 19)},
+			},
+		},
+		{
+			name:  "weekly on weekdays, starting in the middle of the week",
+			rule:  RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1, Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}},
+			start: utc(9, 6, 10),
+			end:   utc(9, 6, 11),
+			until: utc(18, 6, 10),
+			loc:   time.UTC,
+			want: []Occurrence{
+				{StartTime: utc(9, 6, 10), EndTime: utc(9, 6, 11)},
+				{StartTime: utc(11, 6, 10), EndTime: utc(11, 6, 11)},
+				{StartTime: utc(14, 6, 10), EndTime: utc(14, 6, 11)},
+				{StartTime: utc(16, 6, 10), EndTime: utc(16, 6, 11)},
+				{StartTime: utc(18, 6, 10), EndTime: utc(18, 6, 11)},
+			},
+		},
+		{
+			// Weeks start on Monday, so the Monday right after the first Sunday is already in the next week.
+			name:  "weekly with interval across week boundaries",
+			rule:  RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Sunday}},
+			start: utc(6, 6, 10),
+			end:   utc(6, 6, 11),
+			until: utc(21, 6, 10),
+			loc:   time.UTC,
+			want: []Occurrence{
+				{StartTime: utc(6, 6, 10), EndTime: utc(6, 6, 11)},
+				{StartTime: utc(14, 6, 10), EndTime: utc(14, 6, 11)},
+				{StartTime: utc(20, 6, 10), EndTime: utc(20, 6, 11)},
+			},
+		},
+		{
+			name:  "multi-day occurrences",
+			rule:  RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1},
+			start: utc(4, 6, 18),
+			end:   utc(6, 6, 10),
+			until: utc(18, 6, 18),
+			loc:   time.UTC,
+			want: []Occurrence{
+				{StartTime: utc(4, 6, 18), EndTime: utc(6, 6, 10)},
+				{StartTime: utc(11, 6, 18), EndTime: utc(13, 6, 10)},
+				{StartTime: utc(18, 6, 18), EndTime: utc(20, 6, 10)},
+			},
+		},
+		{
+			name:    "overlapping occurrences",
+			rule:    RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+			start:   utc(7, 6, 10),
+			end:     utc(8, 6, 12),
+			until:   utc(10, 6, 10),
+			loc:     time.UTC,
+			wantErr: true,
+		},
+		{
+			name:  "occurrence ending when the next one starts",
+			rule:  RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1},
+			start: utc(7, 6, 10),
+			end:   utc(8, 6, 10),
+			until: utc(8, 6, 10),
+			loc:   time.UTC,
+			want: []Occurrence{
+				{StartTime: utc(7, 6, 10), EndTime: utc(8, 6, 10)},
+				{StartTime: utc(8, 6, 10), EndTime: utc(9, 6, 10)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Occurrences(tt.start, tt.end, tt.until, tt.loc)
+			if tt.wantErr {
+				if !app.IsValidationError(err) {
+					t.Fatalf("Occurrences() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Occurrences() unexpected error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() returned %d occurrences, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i := range tt.want {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) {
+					t.Errorf("occurrence %d = %s - %s, want %s - %s",
+						i, got[i].StartTime, got[i].EndTime, tt.want[i].StartTime, tt.want[i].EndTime)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleOccurrencesLimit(t *testing.T) {
+	start := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	rule := RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1}
+
+	tests := []struct {
+		name    string
+		days    int
+		wantErr bool
+	}{
+		{name: "max occurrences", days: MaxRecurrenceOccurrences},
+		{name: "one occurrence over the limit", days: MaxRecurrenceOccurrences + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until := start.AddDate(0, 0, tt.days-1)
+
+			got, err := rule.Occurrences(start, start.Add(time.Hour), until, time.UTC)
+			if tt.wantErr {
+				if !app.IsValidationError(err) {
+					t.Fatalf("Occurrences() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Occurrences() unexpected error = %v", err)
+			}
+			if len(got) != tt.days {
+				t.Fatalf("Occurrences() returned %d occurrences, want %d", len(got), tt.days)
+			}
+		})
+	}
+}
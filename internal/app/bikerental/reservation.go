@@ -19,8 +19,26 @@ const (
 	ReservationStatusRejected ReservationStatus = "rejected"
 	ReservationStatusApproved ReservationStatus = "approved"
 	ReservationStatusCanceled ReservationStatus = "canceled"
+
+	// ReservationStatusActive means the bike was picked up and the rental is in progress.
+	ReservationStatusActive ReservationStatus = "active"
+
+	// ReservationStatusCompleted means the bike was returned.
+	ReservationStatusCompleted ReservationStatus = "completed"
+
+	// ReservationStatusNoShow means the customer didn't pick up the bike.
+	ReservationStatusNoShow ReservationStatus = "no_show"
+
+	// ReservationStatusPending means the bike is held until HoldExpiresAt, waiting for confirmation.
+	ReservationStatusPending ReservationStatus = "pending"
+
+	// ReservationStatusExpired means the hold wasn't confirmed in time.
+	ReservationStatusExpired ReservationStatus = "expired"
 )
 
+// ErrBikeNotAvailable is returned when the bike has blocking reservations or maintenance windows in requested time range.
+var ErrBikeNotAvailable = app.NewConflictError("bike not available in requested time range")
+
 // Reservation represents reservation for a bike.
 // Values are in fixed currency, we won't deal with currencies in decimals here for simplicity.
 type Reservation struct {
@@ -36,6 +54,43 @@ type Reservation struct {
 
 	// AppliedDiscount is amount of discount applied to total reservation value in euro-cents.
 	AppliedDiscount int
+
+	// DiscountRule identifies the discount rule that produced applied discount.
+	// It's empty if no discount was applied.
+	DiscountRule string
+
+	// PromoCode is a promo code redeemed with the reservation. It's empty if no code was used.
+	PromoCode string
+
+	// PickedUpAt is an actual time of picking up the bike. It's zero if the rental didn't start.
+	PickedUpAt time.Time
+
+	// ReturnedAt is an actual time of returning the bike. It's zero if the rental isn't completed.
+	ReturnedAt time.Time
+
+	// SeriesID links reservations created from one recurring reservation request.
+	// It's empty for single reservations.
+	SeriesID string
+
+	// GroupID links reservations of multiple bikes booked together.
+	// It's empty for single reservations.
+	GroupID string
+
+	// HoldExpiresAt is a time until pending reservation blocks the bike. It's zero for reservations created without hold.
+	HoldExpiresAt time.Time
+
+	// Refund and CancellationFee are amounts in euro-cents settled when reservation was canceled.
+	Refund          int
+	CancellationFee int
+
+	// CanceledAt is a time of canceling the reservation. It's zero if reservation wasn't canceled.
+	CanceledAt time.Time
+}
+
+// HoldExpired returns true if reservation is a pending hold, which expired at time `t`.
+// Expired holds don't block the bike, even before their status is changed to expired.
+func (r Reservation) HoldExpired(t time.Time) bool {
+	return r.Status == ReservationStatusPending && !t.Before(r.HoldExpiresAt)
 }
 
 // Validate validates reservation data.
@@ -49,10 +104,26 @@ func (r Reservation) Validate() error {
 
 // ReservationService provides methods for making reservations.
 type ReservationService interface {
-	GetBikeAvailability(ctx context.Context, bikeID string, startTime, endTime time.Time) (bool, error)
-	ListReservations(ctx context.Context, req ListReservationsRequest) ([]Reservation, error)
+	GetBikeAvailability(ctx context.Context, bikeID string, startTime, endTime time.Time, location *Location) (bool, error)
+	SearchAvailableBikes(ctx context.Context, req SearchAvailableBikesRequest) ([]AvailableBike, error)
+	ListReservations(ctx context.Context, req ListReservationsRequest) (*ListReservationsResponse, error)
 	CreateReservation(ctx context.Context, req CreateReservationRequest) (*ReservationResponse, error)
-	CancelReservation(ctx context.Context, bikeID string, id string) error
+	CreateRecurringReservation(ctx context.Context, req CreateRecurringReservationRequest) (*RecurringReservationResponse, error)
+	CreateGroupReservation(ctx context.Context, req CreateGroupReservationRequest) (*GroupReservationResponse, error)
+	UpdateReservation(ctx context.Context, req UpdateReservationRequest) (*UpdateReservationResponse, error)
+	HoldBike(ctx context.Context, req CreateReservationRequest) (*ReservationResponse, error)
+	ConfirmReservation(ctx context.Context, bikeID string, id string) (*Reservation, error)
+	ExpireHolds(ctx context.Context) (*ExpireHoldsResponse, error)
+	CancelReservation(ctx context.Context, bikeID string, id string) (*CancelReservationResponse, error)
+	CancelReservationSeries(ctx context.Context, bikeID string, seriesID string) (*CancelReservationSeriesResponse, error)
+	JoinWaitlist(ctx context.Context, req CreateReservationRequest) (*WaitlistEntry, error)
+	ListWaitlist(ctx context.Context, bikeID string) ([]WaitlistEntry, error)
+	WithdrawWaitlistEntry(ctx context.Context, bikeID string, id string) error
+	StartRental(ctx context.Context, bikeID string, id string) (*Reservation, error)
+	CompleteRental(ctx context.Context, bikeID string, id string) (*Reservation, error)
+	MarkNoShow(ctx context.Context, bikeID string, id string) (*NoShowResponse, error)
+	GetInvoice(ctx context.Context, bikeID string, id string) (*Invoice, error)
+	CheckDiscount(ctx context.Context, req CheckDiscountRequest) (*CheckDiscountResponse, error)
 }
 
 // CreateReservationRequest is a request for creating new reservation.
@@ -62,6 +133,9 @@ type CreateReservationRequest struct {
 	Location  Location
 	StartTime time.Time
 	EndTime   time.Time
+
+	// PromoCode is optional.
+	PromoCode string
 }
 
 // Validate validates request data.
@@ -89,7 +163,7 @@ func (r *CreateReservationRequest) Validate() error {
 }
 
 // ReservationResponse is a response for create reservation request.
-// If status is other than "approved", `Reservation` attribute will be nil.
+// If status is other than "approved" or "pending", `Reservation` attribute will be nil.
 type ReservationResponse struct {
 	Status ReservationStatus
 
@@ -97,15 +171,97 @@ type ReservationResponse struct {
 	// If status is "approved", it should be empty.
 	Reason string
 
-	// Reservation will be empty for statuses other than "approved".
+	// Reservation will be empty for statuses other than "approved" or "pending".
 	Reservation *Reservation
 }
 
+// UpdateReservationRequest is a request for changing time range or bike of existing reservation.
+type UpdateReservationRequest struct {
+	ID     string
+	BikeID string
+
+	// NewBikeID is optional. If empty, the reservation stays with the current bike.
+	NewBikeID string
+
+	Location  Location
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Validate validates request data.
+func (r *UpdateReservationRequest) Validate() error {
+	if _, err := uuid.Parse(r.ID); err != nil {
+		return app.NewValidationError("reservation id is invalid")
+	}
+	if _, err := uuid.Parse(r.BikeID); err != nil {
+		return app.NewValidationError("bike id is invalid")
+	}
+	if r.NewBikeID != "" {
+		if _, err := uuid.Parse(r.NewBikeID); err != nil {
+			return app.NewValidationError("new bike id is invalid")
+		}
+	}
+	if err := r.Location.Validate(); err != nil {
+		return fmt.Errorf("invalid location data: %w", err)
+	}
+
+	if r.StartTime.Before(time.Now()) {
+		return app.NewValidationError("start time can't be in the past")
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+
+	return nil
+}
+
+// UpdateReservationResponse is a response for update reservation request.
+// If status is "rejected", `Reservation` attribute will be nil, and the reservation is left unchanged.
+type UpdateReservationResponse struct {
+	Status ReservationStatus
+
+	// Reason contains reason of rejecting the change.
+	Reason string
+
+	// Reservation contains updated reservation data.
+	Reservation *Reservation
+
+	// PriceDifference is a change of reservation total value in euro-cents.
+	// Positive value has to be paid by the customer, negative one is refunded.
+	PriceDifference int
+
+	// Promoted contains reservations created from waitlist entries for the freed time range.
+	Promoted []Reservation
+}
+
+// NoShowResponse is a response for mark no-show request.
+type NoShowResponse struct {
+	Reservation *Reservation
+
+	// Promoted contains reservations created from waitlist entries for the freed time range.
+	Promoted []Reservation
+}
+
+// ExpireHoldsResponse is a response for expire holds request.
+type ExpireHoldsResponse struct {
+	// Expired is a number of expired reservations.
+	Expired int
+
+	// Promoted contains reservations created from waitlist entries for the freed time ranges.
+	Promoted []Reservation
+}
+
 // ListReservationsRequest is a request for listing reservations.
 type ListReservationsRequest struct {
 	BikeID    string
 	StartTime time.Time
 	EndTime   time.Time
+
+	// PageSize limits number of returned reservations. Zero means default size.
+	PageSize int
+
+	// PageToken is a token returned in previous response, used for fetching the next page.
+	PageToken string
 }
 
 // Validate validates request data.
@@ -127,3 +283,56 @@ func (r *ListReservationsRequest) Validate() error {
 
 	return nil
 }
+
+// ListReservationsResponse is a page of reservations.
+type ListReservationsResponse struct {
+	Reservations []Reservation
+
+	// NextPageToken is empty if there are no more pages.
+	NextPageToken string
+}
+
+// CheckDiscountRequest is a request for checking possible discount for a bike rental.
+// It doesn't reserve the bike, so bike availability doesn't matter.
+type CheckDiscountRequest struct {
+	BikeID    string
+	Customer  Customer
+	Location  Location
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Validate validates request data.
+func (r *CheckDiscountRequest) Validate() error {
+	if _, err := uuid.Parse(r.BikeID); err != nil {
+		return app.NewValidationError("bike id is invalid")
+	}
+	if r.Customer.ID == "" {
+		if err := r.Customer.Validate(); err != nil {
+			return fmt.Errorf("invalid customer data: %w", err)
+		}
+	}
+	if err := r.Location.Validate(); err != nil {
+		return fmt.Errorf("invalid location data: %w", err)
+	}
+
+	if r.StartTime.Before(time.Now()) {
+		return app.NewValidationError("start time can't be in the past")
+	}
+	if !r.EndTime.After(r.StartTime) {
+		return app.NewValidationError("end time have to ba after start time")
+	}
+
+	return nil
+}
+
+// CheckDiscountResponse is a response with possible discount for a bike rental.
+type CheckDiscountResponse struct {
+	// ReservationValue is a reservation value before discount in euro-cents.
+	ReservationValue int
+
+	Discount Discount
+
+	// Candidates contains all evaluated discount rules, including those that didn't apply.
+	Candidates []Discount
+}
@@ -0,0 +1,175 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// CreateGroupReservation reserves multiple bikes for one customer and time range.
+// All bikes are reserved together, or none of them, if any bike is not available.
+// Discount is calculated for the combined value of all bikes, and split between reservations proportionally to their value.
+// Like CreateReservation, it returns valid response with rejected status when reservation is not possible.
+func (s *Service) CreateGroupReservation(ctx context.Context, req bikerental.CreateGroupReservationRequest) (*bikerental.GroupReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CreateGroupReservation")
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	bikes := make([]bikerental.Bike, 0, len(req.BikeIDs))
+	for _, id := range req.BikeIDs {
+		bike, err := s.fetchRealBike(ctx, id)
+		if err != nil {
+			if app.IsNotFoundError(err) {
+				s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotFound)
+				return &bikerental.GroupReservationResponse{
+					Status: bikerental.ReservationStatusRejected,
+					Reason: fmt.Sprintf("bike with id '%s' does not exists", id),
+				}, nil
+			}
+			return nil, err
+		}
+		reason, err := s.checkBikeRentable(ctx, *bike, &req.Location)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotRentable)
+			return &bikerental.GroupReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: fmt.Sprintf("bike '%s': %s", id, reason),
+			}, nil
+		}
+		bikes = append(bikes, *bike)
+	}
+
+	customer, err := s.updateCustomerData(ctx, req.Customer)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]int, 0, len(bikes))
+	var totalValue int
+	// Bike weight discount is based on the lightest bike in the group.
+	lightest := bikes[0]
+	for _, bike := range bikes {
+		v := s.calculateReservationValue(bike, req.StartTime, req.EndTime)
+		values = append(values, v)
+		totalValue += v
+		if bike.Weight < lightest.Weight {
+			lightest = bike
+		}
+	}
+
+	discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
+		Customer:         customer,
+		Location:         req.Location,
+		Bike:             lightest,
+		ReservationValue: totalValue,
+		GroupSize:        len(bikes),
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking available discounts: %w", err)
+	}
+	discount := discountResp.Discount
+	discounts := splitDiscount(discount.Amount, values, totalValue)
+
+	groupID := uuid.NewString()
+	reservations := make([]bikerental.Reservation, 0, len(bikes))
+	for i, bike := range bikes {
+		reservations = append(reservations, bikerental.Reservation{
+			ID:              uuid.NewString(),
+			Status:          bikerental.ReservationStatusApproved,
+			Customer:        customer,
+			Bike:            bike,
+			StartTime:       req.StartTime,
+			EndTime:         req.EndTime,
+			TotalValue:      values[i] - discounts[i],
+			AppliedDiscount: discounts[i],
+			DiscountRule:    discount.Rule,
+			GroupID:         groupID,
+		})
+	}
+
+	created, conflicts, err := s.reservationsRepo.CreateAll(ctx, reservations)
+	if err != nil {
+		if app.IsConflictError(err) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotAvailable)
+			return &bikerental.GroupReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: "bikes not available in requested time range",
+			}, nil
+		}
+		return nil, fmt.Errorf("creating reservations in repository: %w", err)
+	}
+	if len(conflicts) > 0 {
+		s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotAvailable)
+		resp := &bikerental.GroupReservationResponse{
+			Status: bikerental.ReservationStatusRejected,
+		}
+		for _, i := range conflicts {
+			resp.UnavailableBikeIDs = append(resp.UnavailableBikeIDs, bikes[i].ID)
+		}
+		resp.Reason = fmt.Sprintf("bikes not available in requested time range: %s", strings.Join(resp.UnavailableBikeIDs, ", "))
+		return resp, nil
+	}
+	for _, r := range created {
+		s.businessMetrics.ReservationCreated(r)
+	}
+
+	return &bikerental.GroupReservationResponse{
+		Status:       bikerental.ReservationStatusApproved,
+		GroupID:      groupID,
+		Reservations: created,
+		Discount:     discount,
+	}, nil
+}
+
+// splitDiscount divides discount amount between reservations proportionally to their values.
+// No reservation gets a discount greater than its value.
+// Rounding remainder is added to the reservation with the greatest value, and if it doesn't fit there,
+// to the next greatest ones, so parts always sum up to `amount`, as long as it doesn't exceed total value.
+func splitDiscount(amount int, values []int, totalValue int) []int {
+	result := make([]int, len(values))
+	if amount == 0 || totalValue == 0 {
+		return result
+	}
+
+	remainder := amount
+	for i, v := range values {
+		result[i] = amount * v / totalValue
+		if result[i] > v {
+			result[i] = v
+		}
+		remainder -= result[i]
+	}
+
+	byValue := make([]int, len(values))
+	for i := range byValue {
+		byValue[i] = i
+	}
+	sort.SliceStable(byValue, func(a, b int) bool {
+		return values[byValue[a]] > values[byValue[b]]
+	})
+	for _, i := range byValue {
+		if remainder <= 0 {
+			break
+		}
+		add := values[i] - result[i]
+		if add > remainder {
+			add = remainder
+		}
+		result[i] += add
+		remainder -= add
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package reservation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitDiscount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int
+		values []int
+		want   []int
+	}{
+		{
+			name:   "no discount",
+			amount: 0,
+			values: []int{1000, 2000},
+			want:   []int{0, 0},
+		},
+		{
+			name:   "single reservation",
+			amount: 300,
+			values: []int{1000},
+			want:   []int{300},
+		},
+		{
+			name:   "divides without remainder",
+			amount: 300,
+			values: []int{1000, 2000},
+			want:   []int{100, 200},
+		},
+		{
+			name:   "remainder goes to the greatest value",
+			amount: 100,
+			values: []int{1000, 2000, 1000},
+			want:   []int{25, 50, 25},
+		},
+		{
+			name:   "rounding remainder goes to the greatest value, not the last one",
+			amount: 5,
+			values: []int{2, 3, 2},
+			want:   []int{1, 3, 1},
+		},
+		{
+			name:   "whole value discounted",
+			amount: 4,
+			values: []int{1, 1, 2},
+			want:   []int{1, 1, 2},
+		},
+		{
+			name:   "remainder over the greatest value goes to the next ones",
+			amount: 3,
+			values: []int{1, 1, 2},
+			want:   []int{1, 0, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var total int
+			for _, v := range tt.values {
+				total += v
+			}
+
+			got := splitDiscount(tt.amount, tt.values, total)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("splitDiscount() = %v, want %v", got, tt.want)
+			}
+			var sum int
+			for i, d := range got {
+				if d > tt.values[i] {
+					t.Fatalf("discount %d = %d exceeds reservation value %d", i, d, tt.values[i])
+				}
+				sum += d
+			}
+			if sum != tt.amount {
+				t.Fatalf("discounts sum up to %d, want %d", sum, tt.amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package reservation
+
+import (
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// transitions defines allowed reservation status changes.
+// Statuses missing from the table are final.
+var transitions = map[bikerental.ReservationStatus][]bikerental.ReservationStatus{
+	bikerental.ReservationStatusPending: {
+		bikerental.ReservationStatusApproved,
+		bikerental.ReservationStatusCanceled,
+		bikerental.ReservationStatusExpired,
+	},
+	bikerental.ReservationStatusApproved: {
+		bikerental.ReservationStatusCanceled,
+		bikerental.ReservationStatusActive,
+		bikerental.ReservationStatusNoShow,
+	},
+	bikerental.ReservationStatusActive: {
+		bikerental.ReservationStatusCompleted,
+	},
+}
+
+// checkTransition returns app.ConflictError if reservation can't change status from `from` to `to`.
+func checkTransition(from, to bikerental.ReservationStatus) error {
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return app.NewConflictError(fmt.Sprintf("reservation status can't change from '%s' to '%s'", from, to))
+}
+
+// blockingStatuses are statuses of reservations that make the bike unavailable in reservation time range.
+// Pending reservations block the bike only until their hold expires.
+var blockingStatuses = []bikerental.ReservationStatus{
+	bikerental.ReservationStatusApproved,
+	bikerental.ReservationStatusActive,
+	bikerental.ReservationStatusPending,
+}
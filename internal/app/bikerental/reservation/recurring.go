@@ -0,0 +1,198 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// CreateRecurringReservation creates a series of reservations expanded from recurrence rule.
+// All occurrences are created together, or none of them, if bike is not available for some of them.
+// Like CreateReservation, it returns valid response with rejected status when reservation is not possible.
+func (s *Service) CreateRecurringReservation(ctx context.Context, req bikerental.CreateRecurringReservationRequest) (*bikerental.RecurringReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CreateRecurringReservation", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	rule, err := bikerental.ParseRecurrenceRule(req.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	loc, err := time.LoadLocation(req.TimeZone)
+	if err != nil {
+		return nil, app.NewValidationError(fmt.Sprintf("invalid time zone '%s'", req.TimeZone))
+	}
+	occurrences, err := rule.Occurrences(req.StartTime, req.EndTime, req.Until, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(occurrences) == 0 {
+		return nil, app.NewValidationError("recurrence rule doesn't match any day")
+	}
+
+	bike, err := s.fetchRealBike(ctx, req.BikeID)
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotFound)
+			return &bikerental.RecurringReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: fmt.Sprintf("bike with id '%s' does not exists", req.BikeID),
+			}, nil
+		}
+		return nil, err
+	}
+	reason, err := s.checkBikeRentable(ctx, *bike, &req.Location)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotRentable)
+		return &bikerental.RecurringReservationResponse{
+			Status: bikerental.ReservationStatusRejected,
+			Reason: reason,
+		}, nil
+	}
+
+	customer, err := s.updateCustomerData(ctx, req.Customer)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesID := uuid.NewString()
+	reservations := make([]bikerental.Reservation, 0, len(occurrences))
+	for _, occ := range occurrences {
+		value := s.calculateReservationValue(*bike, occ.StartTime, occ.EndTime)
+		discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
+			Customer:         customer,
+			Location:         req.Location,
+			Bike:             *bike,
+			ReservationValue: value,
+			StartTime:        occ.StartTime,
+			EndTime:          occ.EndTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("checking available discounts: %w", err)
+		}
+
+		reservations = append(reservations, bikerental.Reservation{
+			ID:              uuid.NewString(),
+			Status:          bikerental.ReservationStatusApproved,
+			Customer:        customer,
+			Bike:            *bike,
+			StartTime:       occ.StartTime,
+			EndTime:         occ.EndTime,
+			TotalValue:      value - discountResp.Discount.Amount,
+			AppliedDiscount: discountResp.Discount.Amount,
+			DiscountRule:    discountResp.Discount.Rule,
+			SeriesID:        seriesID,
+		})
+	}
+
+	created, conflicts, err := s.reservationsRepo.CreateAll(ctx, reservations)
+	if err != nil {
+		if app.IsConflictError(err) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotAvailable)
+			return &bikerental.RecurringReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: "bike not available in requested time ranges",
+			}, nil
+		}
+		return nil, fmt.Errorf("creating reservations in repository: %w", err)
+	}
+	if len(conflicts) > 0 {
+		s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotAvailable)
+		resp := &bikerental.RecurringReservationResponse{
+			Status: bikerental.ReservationStatusRejected,
+			Reason: fmt.Sprintf("bike not available for %d of %d occurrences", len(conflicts), len(occurrences)),
+		}
+		for _, i := range conflicts {
+			resp.Conflicts = append(resp.Conflicts, occurrences[i])
+		}
+		return resp, nil
+	}
+	for _, r := range created {
+		s.businessMetrics.ReservationCreated(r)
+	}
+
+	return &bikerental.RecurringReservationResponse{
+		Status:       bikerental.ReservationStatusApproved,
+		SeriesID:     seriesID,
+		Reservations: created,
+	}, nil
+}
+
+// CancelReservationSeries cancels all approved reservations of a bike from given series.
+// Refunds and cancellation fees are calculated like in CancelReservation, with the policy of customer type.
+// Reservations which are not approved, or already ended, are left unchanged.
+// Freed time ranges are offered to the oldest fitting waitlist entries.
+// Returns app.ErrNotFound if series doesn't exist.
+func (s *Service) CancelReservationSeries(ctx context.Context, bikeID string, seriesID string) (*bikerental.CancelReservationSeriesResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CancelReservationSeries", app.AttrBikeID(bikeID))
+	defer span.End()
+
+	if _, err := uuid.Parse(bikeID); err != nil {
+		return nil, app.NewValidationError("bike id is invalid")
+	}
+	if _, err := uuid.Parse(seriesID); err != nil {
+		return nil, app.NewValidationError("series id is invalid")
+	}
+
+	reservations, err := s.reservationsRepo.List(ctx, ListReservationsQuery{
+		BikeID:   bikeID,
+		SeriesID: seriesID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching reservations from repository: %w", err)
+	}
+	if len(reservations) == 0 {
+		return nil, app.ErrNotFound
+	}
+
+	// All reservations in the series belong to the same customer.
+	customer, err := s.customersRepo.Get(ctx, reservations[0].Customer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching customer from repository: %w", err)
+	}
+	policy := s.cancellationPolicies[customer.Type]
+
+	now := time.Now()
+	var (
+		cancellations []bikerental.Cancellation
+		updates       []StatusUpdate
+	)
+	for _, r := range reservations {
+		if r.Status != bikerental.ReservationStatusApproved || !now.Before(r.EndTime) {
+			continue
+		}
+		cancellation := policy.Cancel(r, now)
+		cancellations = append(cancellations, cancellation)
+		updates = append(updates, StatusUpdate{
+			ID:           r.ID,
+			From:         r.Status,
+			To:           bikerental.ReservationStatusCanceled,
+			Cancellation: &cancellation,
+		})
+	}
+	if len(updates) == 0 {
+		return &bikerental.CancelReservationSeriesResponse{}, nil
+	}
+
+	promoted, err := s.reservationsRepo.Release(ctx, updates...)
+	if err != nil {
+		return nil, fmt.Errorf("canceling reservation series in repository: %w", err)
+	}
+	for _, c := range cancellations {
+		s.businessMetrics.ReservationCanceled(c)
+	}
+
+	return &bikerental.CancelReservationSeriesResponse{
+		Cancellations: cancellations,
+		Promoted:      s.promoted(ctx, promoted),
+	}, nil
+}
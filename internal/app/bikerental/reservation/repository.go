@@ -4,35 +4,151 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 )
 
 // Repository provides methods for reading/writing reservation data.
 type Repository interface {
-	// List returns list of reservations matching request criteria.
+	// List returns list of reservations matching request criteria, sorted by start time and id.
 	List(context.Context, ListReservationsQuery) ([]bikerental.Reservation, error)
 
+	// SearchAvailableBikes returns bikes in service, without reservations blocking them in query time range.
+	// Bikes are sorted by distance and price.
+	SearchAvailableBikes(context.Context, AvailableBikesQuery) ([]bikerental.AvailableBike, error)
+
 	// Get returns a reservation by id.
 	// Returns app.ErrNotFound if reservation doesn't exist.
 	Get(ctx context.Context, id string) (*bikerental.Reservation, error)
 
 	// Create creates new reservation for a bike.
 	// If any reservation for this bike exists within given time range, will return bikerental.ConflictError.
+	// If customer id is empty, customer is resolved by email, and created only if it doesn't exist.
 	// Returns created reservation data with filled all ids.
 	Create(context.Context, bikerental.Reservation) (*bikerental.Reservation, error)
 
-	// SetStatus updates the status of the reservation by its id.
-	// Returns app.ErrNotFound if reservation doesn't exist.
-	SetStatus(ctx context.Context, id string, status bikerental.ReservationStatus) error
+	// CreateAll creates reservations for one customer in a single transaction.
+	// If any of them overlaps with existing reservations or maintenance windows, nothing is created,
+	// and indexes of conflicting reservations are returned.
+	// Customer is resolved like in Create.
+	CreateAll(context.Context, []bikerental.Reservation) (created []bikerental.Reservation, conflicts []int, err error)
+
+	// Update changes bike, time range and value of the reservation, if its current status is `reservation.Status`.
+	// Bike availability is checked without the reservation itself, and the change is applied in a single transaction.
+	// Time range freed by the change is offered to waitlist like in Release.
+	// Returns app.ErrNotFound if reservation doesn't exist,
+	// bikerental.ErrBikeNotAvailable if the bike is not available, and app.ConflictError if reservation status has changed.
+	Update(context.Context, bikerental.Reservation) (updated *bikerental.Reservation, promoted []bikerental.Reservation, err error)
+
+	// UpdateStatus changes the status of the reservation, if its current status is `update.From`.
+	// Returns app.ErrNotFound if reservation doesn't exist,
+	// and app.ConflictError if reservation has status other than `update.From`.
+	UpdateStatus(context.Context, StatusUpdate) error
+
+	// Release changes statuses of reservations like UpdateStatus, to statuses which don't block the bike,
+	// like canceled or no-show. All updates are applied in a single transaction, or none of them.
+	// In the same transaction, for every freed time range the oldest waiting waitlist entry overlapping with it,
+	// for which the bike in service became available, is promoted to approved reservation,
+	// and customer notification is recorded.
+	// Returns promoted reservations.
+	Release(ctx context.Context, updates ...StatusUpdate) (promoted []bikerental.Reservation, err error)
+
+	// ExpireHolds changes status of pending reservations with hold expired at time `t` to expired.
+	// Promo code redemptions of expired reservations are released.
+	// Freed time ranges are offered to waitlist like in Release.
+	// Returns number of expired reservations.
+	ExpireHolds(ctx context.Context, t time.Time) (expired int, promoted []bikerental.Reservation, err error)
+
+	// Complete changes the status of the reservation like UpdateStatus, and saves its invoice.
+	Complete(context.Context, StatusUpdate, bikerental.Invoice) error
+
+	// GetInvoice returns invoice for a reservation.
+	// Returns app.ErrNotFound if invoice doesn't exist.
+	GetInvoice(ctx context.Context, reservationID string) (*bikerental.Invoice, error)
+}
+
+// StatusUpdate describes reservation status change.
+type StatusUpdate struct {
+	ID   string
+	From bikerental.ReservationStatus
+	To   bikerental.ReservationStatus
+
+	// PickedUpAt and ReturnedAt are saved only if not zero.
+	PickedUpAt time.Time
+	ReturnedAt time.Time
+
+	// HeldAt makes the update conditional on pending reservation hold not being expired at that time.
+	// Ignored if zero.
+	HeldAt time.Time
+
+	// Cancellation is saved only if not nil.
+	Cancellation *bikerental.Cancellation
 }
 
 // ListReservationsQuery is a set of filters for reservations result.
 type ListReservationsQuery struct {
 	BikeID    string
+	SeriesID  string
 	StartTime time.Time
 	EndTime   time.Time
-	Status    bikerental.ReservationStatus
-	Limit     int
+	// Statuses returns only reservations with one of given statuses. Ignored if empty.
+	Statuses []bikerental.ReservationStatus
+
+	// After returns only reservations sorted after given cursor. Ignored if empty.
+	After Cursor
+
+	// Limit is a max number of returned reservations. Zero means no limit.
+	Limit int
+}
+
+// Cursor is a position of a reservation in the list sorted by start time and id.
+type Cursor struct {
+	StartTime time.Time `json:"s"`
+	ID        string    `json:"i"`
+}
+
+// Validate checks that cursor decoded from page token points to a valid reservation id.
+func (c Cursor) Validate() error {
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return app.NewValidationError("invalid page token")
+	}
+	return nil
+}
+
+// AvailableBikesQuery is a set of filters for available bikes result.
+type AvailableBikesQuery struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Location is optional. If set, only bikes at stations within `Radius` meters are returned.
+	Location *bikerental.Location
+	Radius   float64
+
+	// Optional filters, zero values are ignored.
+	MinWeight       float64
+	MaxWeight       float64
+	MaxPricePerHour int
+
+	Limit int
+}
+
+// WaitlistRepository provides methods for reading/writing waitlist entries.
+type WaitlistRepository interface {
+	// List returns all waitlist entries for a bike, sorted by creation time.
+	List(ctx context.Context, bikeID string) ([]bikerental.WaitlistEntry, error)
+
+	// Get returns waitlist entry by id.
+	// Returns app.ErrNotFound if entry doesn't exist.
+	Get(ctx context.Context, id string) (*bikerental.WaitlistEntry, error)
+
+	// Create creates new waitlist entry.
+	// If customer id is empty, customer is resolved by email, and created only if it doesn't exist.
+	Create(context.Context, bikerental.WaitlistEntry) (*bikerental.WaitlistEntry, error)
+
+	// Withdraw changes waiting entry status to withdrawn.
+	// Returns app.ErrNotFound if entry doesn't exist, and app.ConflictError if it's not waiting.
+	Withdraw(ctx context.Context, id string) error
 }
 
 // CustomerRepository provides methods for reading customer data.
@@ -40,4 +156,8 @@ type CustomerRepository interface {
 	// Get returns customer by id.
 	// Returns app.ErrNotFound if customer doesn't exist.
 	Get(ctx context.Context, id string) (*bikerental.Customer, error)
+
+	// GetByEmail returns customer by email, compared case-insensitively.
+	// Returns app.ErrNotFound if customer doesn't exist.
+	GetByEmail(ctx context.Context, email string) (*bikerental.Customer, error)
 }
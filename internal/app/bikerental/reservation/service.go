@@ -14,18 +14,46 @@ import (
 
 // Service provides methods for making reservations.
 type Service struct {
-	discountService  bikerental.DiscountService
-	bikeService      bikerental.BikeService
-	reservationsRepo Repository
-	customersRepo    CustomerRepository
+	discountService    bikerental.DiscountService
+	bikeService        bikerental.BikeService
+	promoCodeService   bikerental.PromoCodeService
+	stationService     bikerental.StationService
+	maintenanceService bikerental.MaintenanceService
+	reservationsRepo   Repository
+	customersRepo      CustomerRepository
+	waitlistRepo       WaitlistRepository
+	businessMetrics    bikerental.BusinessMetrics
+	tracer             app.Tracer
+
+	// lateFeePerHour is a penalty in euro-cents for every started hour of late bike return.
+	lateFeePerHour int
+
+	// maxStationDistance is a max distance in meters between requested rental location and bike station.
+	maxStationDistance float64
+
+	// holdDuration is a time for which HoldBike blocks the bike before confirmation.
+	holdDuration time.Duration
+
+	// cancellationPolicies define refunds for canceled reservations of each customer type.
+	cancellationPolicies bikerental.CancellationPolicies
 }
 
 // NewService creates new service instance.
 func NewService(
 	discountService bikerental.DiscountService,
 	bikeService bikerental.BikeService,
+	promoCodeService bikerental.PromoCodeService,
+	stationService bikerental.StationService,
+	maintenanceService bikerental.MaintenanceService,
 	reservationsRepo Repository,
 	customersRepo CustomerRepository,
+	waitlistRepo WaitlistRepository,
+	businessMetrics bikerental.BusinessMetrics,
+	tracer app.Tracer,
+	lateFeePerHour int,
+	maxStationDistance float64,
+	holdDuration time.Duration,
+	cancellationPolicies bikerental.CancellationPolicies,
 ) (*Service, error) {
 	if discountService == nil {
 		return nil, errors.New("empty discount service")
@@ -33,72 +61,275 @@ func NewService(
 	if bikeService == nil {
 		return nil, errors.New("empty bike service")
 	}
+	if promoCodeService == nil {
+		return nil, errors.New("empty promo code service")
+	}
+	if stationService == nil {
+		return nil, errors.New("empty station service")
+	}
+	if maintenanceService == nil {
+		return nil, errors.New("empty maintenance service")
+	}
 	if reservationsRepo == nil {
 		return nil, errors.New("empty reservations repository")
 	}
 	if customersRepo == nil {
 		return nil, errors.New("empty customers repository")
 	}
+	if waitlistRepo == nil {
+		return nil, errors.New("empty waitlist repository")
+	}
+	if businessMetrics == nil {
+		return nil, errors.New("empty business metrics")
+	}
+	if tracer == nil {
+		return nil, errors.New("empty tracer")
+	}
+	if lateFeePerHour < 0 {
+		return nil, errors.New("late fee per hour can't be negative")
+	}
+	if maxStationDistance <= 0 {
+		return nil, errors.New("max station distance has to be positive")
+	}
+	if holdDuration <= 0 {
+		return nil, errors.New("hold duration has to be positive")
+	}
+	for _, t := range []bikerental.CustomerType{bikerental.CustomerTypeIndividual, bikerental.CustomerTypeBusiness} {
+		policy, ok := cancellationPolicies[t]
+		if !ok {
+			return nil, fmt.Errorf("empty cancellation policy for customer type %d", t)
+		}
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid cancellation policy for customer type %d: %w", t, err)
+		}
+	}
 
 	return &Service{
-		discountService:  discountService,
-		bikeService:      bikeService,
-		reservationsRepo: reservationsRepo,
-		customersRepo:    customersRepo,
+		discountService:    discountService,
+		bikeService:        bikeService,
+		promoCodeService:   promoCodeService,
+		stationService:     stationService,
+		maintenanceService: maintenanceService,
+		reservationsRepo:   reservationsRepo,
+		customersRepo:      customersRepo,
+		waitlistRepo:       waitlistRepo,
+		businessMetrics:    businessMetrics,
+		tracer:             tracer,
+		lateFeePerHour:     lateFeePerHour,
+		maxStationDistance: maxStationDistance,
+		holdDuration:       holdDuration,
+
+		cancellationPolicies: cancellationPolicies,
 	}, nil
 }
 
 // GetBikeAvailability returns true if bike with given id is available for rent in given time range.
-func (s *Service) GetBikeAvailability(ctx context.Context, bikeID string, startTime, endTime time.Time) (bool, error) {
+// Bikes out of service or with maintenance scheduled in that time range are never available.
+// If location is not nil, bike has to be at a station near that location.
+func (s *Service) GetBikeAvailability(ctx context.Context, bikeID string, startTime, endTime time.Time, location *bikerental.Location) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.GetBikeAvailability", app.AttrBikeID(bikeID))
+	defer span.End()
+
 	if startTime.Before(time.Now()) {
 		return false, app.NewValidationError("start time has to be in future")
 	}
 	if !endTime.After(startTime) {
 		return false, app.NewValidationError("end time has to be after end time")
 	}
+	if location != nil {
+		if err := location.Validate(); err != nil {
+			return false, fmt.Errorf("invalid location: %w", err)
+		}
+	}
 
-	// Check if bike exists.
-	if _, err := s.bikeService.Get(ctx, bikeID); err != nil {
+	bike, err := s.bikeService.Get(ctx, bikeID)
+	if err != nil {
 		return false, fmt.Errorf("fetching bike data: %w", err)
 	}
+	reason, err := s.checkBikeRentable(ctx, *bike, location)
+	if err != nil {
+		return false, err
+	}
+	if reason != "" {
+		return false, nil
+	}
 
 	reservations, err := s.reservationsRepo.List(ctx, ListReservationsQuery{
 		BikeID:    bikeID,
 		StartTime: startTime,
 		EndTime:   endTime,
-		Status:    bikerental.ReservationStatusApproved,
-		Limit:     1,
+		Statuses:  blockingStatuses,
 	})
 	if err != nil {
 		return false, fmt.Errorf("fetching reservations from repository: %w", err)
 	}
 
-	available := len(reservations) == 0
+	now := time.Now()
+	for _, r := range reservations {
+		if !r.HoldExpired(now) {
+			return false, nil
+		}
+	}
+
+	windows, err := s.maintenanceService.List(ctx, bikeID, startTime, endTime)
+	if err != nil {
+		return false, fmt.Errorf("fetching maintenance windows: %w", err)
+	}
+
+	available := len(windows) == 0
 
 	return available, nil
 }
 
-// ListReservations returns list of reservations matching request criteria.
-func (s *Service) ListReservations(ctx context.Context, req bikerental.ListReservationsRequest) ([]bikerental.Reservation, error) {
+// SearchAvailableBikes returns bikes that can be rented in requested time range, sorted by distance and price.
+// If location is set, only bikes at stations near that location are returned.
+func (s *Service) SearchAvailableBikes(ctx context.Context, req bikerental.SearchAvailableBikesRequest) ([]bikerental.AvailableBike, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.SearchAvailableBikes")
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	limit, err := app.PageSize(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	radius := req.Radius
+	if radius == 0 {
+		radius = s.maxStationDistance
+	}
+
+	bikes, err := s.reservationsRepo.SearchAvailableBikes(ctx, AvailableBikesQuery{
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Location:        req.Location,
+		Radius:          radius,
+		MinWeight:       req.MinWeight,
+		MaxWeight:       req.MaxWeight,
+		MaxPricePerHour: req.MaxPricePerHour,
+		Limit:           limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("searching available bikes in repository: %w", err)
+	}
+	return bikes, nil
+}
+
+// ListReservations returns a page of reservations matching request criteria, sorted by start time.
+func (s *Service) ListReservations(ctx context.Context, req bikerental.ListReservationsRequest) (*bikerental.ListReservationsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.ListReservations", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
+	pageSize, err := app.PageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	var after Cursor
+	if req.PageToken != "" {
+		if err := app.DecodePageToken(req.PageToken, &after); err != nil {
+			return nil, err
+		}
+		if err := after.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
+	// Fetch one more reservation to know if there is a next page.
 	reservations, err := s.reservationsRepo.List(ctx, ListReservationsQuery{
 		BikeID:    req.BikeID,
 		StartTime: req.StartTime,
 		EndTime:   req.EndTime,
+		After:     after,
+		Limit:     pageSize + 1,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("fetching reservations from repository: %w", err)
 	}
-	return reservations, nil
+
+	resp := &bikerental.ListReservationsResponse{
+		Reservations: reservations,
+	}
+	if len(reservations) > pageSize {
+		resp.Reservations = reservations[:pageSize]
+		last := resp.Reservations[pageSize-1]
+		resp.NextPageToken, err = app.EncodePageToken(Cursor{StartTime: last.StartTime, ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 // CreateReservation creates new reservation if possible.
 // If creating reservation is not possible due to business logic or availability issues, this method returns valid response.
 // If there are errors while processing request, returns nil and an error.
 func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateReservationRequest) (*bikerental.ReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CreateReservation", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
+	return s.createReservation(ctx, req, time.Time{})
+}
+
+// HoldBike creates pending reservation, which blocks the bike for configured hold duration.
+// It has to be confirmed with ConfirmReservation before the hold expires.
+// Rejections are returned like in CreateReservation.
+func (s *Service) HoldBike(ctx context.Context, req bikerental.CreateReservationRequest) (*bikerental.ReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.HoldBike", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
+	return s.createReservation(ctx, req, time.Now().Add(s.holdDuration))
+}
+
+// ConfirmReservation changes pending reservation to approved, if its hold hasn't expired.
+// Returns app.ErrNotFound if reservation doesn't exist,
+// and app.ConflictError if reservation isn't pending, or its hold has expired.
+func (s *Service) ConfirmReservation(ctx context.Context, bikeID string, id string) (*bikerental.Reservation, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.ConfirmReservation", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	reservation, err := s.getBikeReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if reservation.HoldExpired(now) {
+		return nil, app.NewConflictError("reservation hold has expired")
+	}
+
+	confirmed, err := s.changeStatus(ctx, reservation, StatusUpdate{
+		To:     bikerental.ReservationStatusApproved,
+		HeldAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.businessMetrics.ReservationCreated(*confirmed)
+
+	return confirmed, nil
+}
+
+// ExpireHolds changes status of pending reservations with expired holds to expired.
+// Freed time ranges are offered to the oldest fitting waitlist entries.
+func (s *Service) ExpireHolds(ctx context.Context) (*bikerental.ExpireHoldsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.ExpireHolds")
+	defer span.End()
+
+	n, promoted, err := s.reservationsRepo.ExpireHolds(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("expiring holds in repository: %w", err)
+	}
+	return &bikerental.ExpireHoldsResponse{
+		Expired:  n,
+		Promoted: s.promoted(ctx, promoted),
+	}, nil
+}
+
+// createReservation creates approved reservation, or pending one if `holdExpiresAt` is not zero.
+func (s *Service) createReservation(ctx context.Context, req bikerental.CreateReservationRequest, holdExpiresAt time.Time) (*bikerental.ReservationResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
@@ -106,6 +337,7 @@ func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateRe
 	bike, err := s.fetchRealBike(ctx, req.BikeID)
 	if err != nil {
 		if app.IsNotFoundError(err) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotFound)
 			return &bikerental.ReservationResponse{
 				Status: bikerental.ReservationStatusRejected,
 				Reason: fmt.Sprintf("bike with id '%s' does not exists", req.BikeID),
@@ -113,6 +345,17 @@ func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateRe
 		}
 		return nil, err
 	}
+	reason, err := s.checkBikeRentable(ctx, *bike, &req.Location)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotRentable)
+		return &bikerental.ReservationResponse{
+			Status: bikerental.ReservationStatusRejected,
+			Reason: reason,
+		}, nil
+	}
 
 	// If the customer exists, we want to have its real data.
 	customer, err := s.updateCustomerData(ctx, req.Customer)
@@ -127,24 +370,72 @@ func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateRe
 		Location:         req.Location,
 		Bike:             *bike,
 		ReservationValue: value,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("checking available discounts: %w", err)
 	}
 
+	discount := discountResp.Discount
+	var promoCode string
+	if req.PromoCode != "" {
+		promo, err := s.promoCodeService.Get(ctx, req.PromoCode)
+		if err != nil {
+			if app.IsNotFoundError(err) || app.IsValidationError(err) {
+				s.businessMetrics.ReservationRejected(bikerental.RejectionPromoCode)
+				return &bikerental.ReservationResponse{
+					Status: bikerental.ReservationStatusRejected,
+					Reason: fmt.Sprintf("promo code '%s' does not exists", req.PromoCode),
+				}, nil
+			}
+			return nil, fmt.Errorf("fetching promo code: %w", err)
+		}
+		if err := promo.CheckActive(time.Now()); err != nil {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionPromoCode)
+			return &bikerental.ReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: err.Error(),
+			}, nil
+		}
+
+		// Promo code is redeemed only if it was actually used.
+		var applied bool
+		if discount, applied = promo.Apply(value, discount); applied {
+			promoCode = promo.Code
+		}
+	}
+
+	status := bikerental.ReservationStatusApproved
+	if !holdExpiresAt.IsZero() {
+		status = bikerental.ReservationStatusPending
+	}
+
 	// We expect repository to return bikerental.ConflictError if reservation for that bike in that time range already exists.
 	reservation, err := s.reservationsRepo.Create(ctx, bikerental.Reservation{
 		ID:              uuid.New().String(),
-		Status:          bikerental.ReservationStatusApproved,
-		Customer:        req.Customer,
+		Status:          status,
+		Customer:        customer,
 		Bike:            *bike,
 		StartTime:       req.StartTime,
 		EndTime:         req.EndTime,
-		TotalValue:      value - discountResp.Discount.Amount,
-		AppliedDiscount: discountResp.Discount.Amount,
+		TotalValue:      value - discount.Amount,
+		AppliedDiscount: discount.Amount,
+		DiscountRule:    discount.Rule,
+		PromoCode:       promoCode,
+		HoldExpiresAt:   holdExpiresAt,
 	})
 	if err != nil {
+		// Promo code could have reached its limits since we fetched it.
+		if errors.Is(err, bikerental.ErrPromoCodeNotRedeemable) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionPromoCode)
+			return &bikerental.ReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: bikerental.ErrPromoCodeNotRedeemable.Error(),
+			}, nil
+		}
 		if app.IsConflictError(err) {
+			s.businessMetrics.ReservationRejected(bikerental.RejectionBikeNotAvailable)
 			return &bikerental.ReservationResponse{
 				Status: bikerental.ReservationStatusRejected,
 				Reason: "bike not available in requested time range",
@@ -153,6 +444,14 @@ func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateRe
 
 		return nil, fmt.Errorf("creating reservation in repository: %w", err)
 	}
+	s.tracer.SpanFromContext(ctx).SetAttributes(
+		app.AttrReservationID(reservation.ID),
+		app.AttrDiscountRule(reservation.DiscountRule),
+	)
+	// Holds are reported when they are confirmed, so abandoned ones don't count as reservations.
+	if reservation.Status == bikerental.ReservationStatusApproved {
+		s.businessMetrics.ReservationCreated(*reservation)
+	}
 
 	return &bikerental.ReservationResponse{
 		Status:      reservation.Status,
@@ -160,23 +459,254 @@ func (s *Service) CreateReservation(ctx context.Context, req bikerental.CreateRe
 	}, nil
 }
 
-// CancelReservation removes reservation by id and bike id.
-// Returns app.ErrNotFound if reservation doesn't exist.
-func (s *Service) CancelReservation(ctx context.Context, bikeID string, id string) error {
+// CancelReservation cancels reservation by id and bike id.
+// Refund and cancellation fee are calculated with the policy of customer type, and saved with the reservation.
+// Freed time range is offered to the oldest fitting waitlist entry.
+// Returns app.ErrNotFound if reservation doesn't exist,
+// and app.ConflictError if reservation can't be canceled, or it has already ended.
+func (s *Service) CancelReservation(ctx context.Context, bikeID string, id string) (*bikerental.CancelReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CancelReservation", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	reservation, err := s.getBikeReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(reservation.Status, bikerental.ReservationStatusCanceled); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !now.Before(reservation.EndTime) {
+		return nil, app.NewConflictError("reservation has already ended")
+	}
+
+	customer, err := s.customersRepo.Get(ctx, reservation.Customer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching customer from repository: %w", err)
+	}
+	cancellation := s.cancellationPolicies[customer.Type].Cancel(*reservation, now)
+
+	promoted, err := s.reservationsRepo.Release(ctx, StatusUpdate{
+		ID:           reservation.ID,
+		From:         reservation.Status,
+		To:           bikerental.ReservationStatusCanceled,
+		Cancellation: &cancellation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("canceling reservation in repository: %w", err)
+	}
+	s.businessMetrics.ReservationCanceled(cancellation)
+
+	return &bikerental.CancelReservationResponse{
+		Cancellation: cancellation,
+		Promoted:     s.promoted(ctx, promoted),
+	}, nil
+}
+
+// StartRental marks reservation as active, when customer picks up the bike.
+// Bike can be picked up only between reservation start and end time,
+// so the rental never overlaps with other reservations of the bike.
+// Returns app.ConflictError if pickup is outside of that time range.
+func (s *Service) StartRental(ctx context.Context, bikeID string, id string) (*bikerental.Reservation, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.StartRental", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	reservation, err := s.getBikeReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if now.Before(reservation.StartTime) {
+		return nil, app.NewConflictError("reservation hasn't started yet")
+	}
+	if !now.Before(reservation.EndTime) {
+		return nil, app.NewConflictError("reservation has already ended")
+	}
+
+	return s.changeStatus(ctx, reservation, StatusUpdate{
+		To:         bikerental.ReservationStatusActive,
+		PickedUpAt: now,
+	})
+}
+
+// CompleteRental marks active reservation as completed, when customer returns the bike.
+// Invoice with final amount, including late return fee, is saved with the reservation.
+func (s *Service) CompleteRental(ctx context.Context, bikeID string, id string) (*bikerental.Reservation, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CompleteRental", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	reservation, err := s.getBikeReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(reservation.Status, bikerental.ReservationStatusCompleted); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	update := StatusUpdate{
+		ID:         reservation.ID,
+		From:       reservation.Status,
+		To:         bikerental.ReservationStatusCompleted,
+		ReturnedAt: now,
+	}
+	invoice := bikerental.NewInvoice(*reservation, now, s.lateFeePerHour)
+	if err := s.reservationsRepo.Complete(ctx, update, invoice); err != nil {
+		return nil, fmt.Errorf("completing reservation in repository: %w", err)
+	}
+	s.businessMetrics.RentalCompleted(invoice)
+
+	reservation.Status = update.To
+	reservation.ReturnedAt = update.ReturnedAt
+	return reservation, nil
+}
+
+// GetInvoice returns invoice of a completed rental.
+// Returns app.ErrNotFound if reservation doesn't exist or isn't completed.
+func (s *Service) GetInvoice(ctx context.Context, bikeID string, id string) (*bikerental.Invoice, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.GetInvoice", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	if _, err := s.getBikeReservation(ctx, bikeID, id); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.reservationsRepo.GetInvoice(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("fetching invoice from repository: %w", err)
+	}
+	return invoice, nil
+}
+
+// MarkNoShow marks reservation as not picked up by the customer.
+// It's possible only after reservation start time.
+// Rest of reservation time range is offered to the oldest fitting waitlist entry.
+func (s *Service) MarkNoShow(ctx context.Context, bikeID string, id string) (*bikerental.NoShowResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.MarkNoShow", app.AttrBikeID(bikeID), app.AttrReservationID(id))
+	defer span.End()
+
+	reservation, err := s.getBikeReservation(ctx, bikeID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Now().Before(reservation.StartTime) {
+		return nil, app.NewConflictError("reservation hasn't started yet")
+	}
+	if err := checkTransition(reservation.Status, bikerental.ReservationStatusNoShow); err != nil {
+		return nil, err
+	}
+
+	promoted, err := s.reservationsRepo.Release(ctx, StatusUpdate{
+		ID:   reservation.ID,
+		From: reservation.Status,
+		To:   bikerental.ReservationStatusNoShow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating reservation status in repository: %w", err)
+	}
+	reservation.Status = bikerental.ReservationStatusNoShow
+
+	return &bikerental.NoShowResponse{
+		Reservation: reservation,
+		Promoted:    s.promoted(ctx, promoted),
+	}, nil
+}
+
+// promoted handles reservations promoted from waitlist, after their time range was freed by other reservation.
+// Promoted reservations are recorded in business metrics like other created reservations.
+// Returns promoted reservations.
+func (s *Service) promoted(ctx context.Context, promoted []bikerental.Reservation) []bikerental.Reservation {
+	for _, r := range promoted {
+		s.tracer.SpanFromContext(ctx).AddEvent("waitlist entry promoted",
+			app.AttrBikeID(r.Bike.ID),
+			app.AttrReservationID(r.ID),
+		)
+		s.businessMetrics.ReservationCreated(r)
+	}
+	return promoted
+}
+
+// getBikeReservation returns reservation by id.
+// Returns app.ErrNotFound if reservation doesn't exist or belongs to other bike.
+func (s *Service) getBikeReservation(ctx context.Context, bikeID string, id string) (*bikerental.Reservation, error) {
 	reservation, err := s.reservationsRepo.Get(ctx, id)
 	if err != nil {
-		return fmt.Errorf("fetching reservation by id from repository: %w", err)
+		return nil, fmt.Errorf("fetching reservation by id from repository: %w", err)
 	}
 
 	// If the bike id doesn't match it's basically the same as invalid reservation id.
 	if reservation.Bike.ID != bikeID {
-		return app.ErrNotFound
+		return nil, app.ErrNotFound
+	}
+	return reservation, nil
+}
+
+// changeStatus moves reservation to `update.To` status, if transition table allows it.
+// Returns updated reservation.
+func (s *Service) changeStatus(ctx context.Context, reservation *bikerental.Reservation, update StatusUpdate) (*bikerental.Reservation, error) {
+	if err := checkTransition(reservation.Status, update.To); err != nil {
+		return nil, err
+	}
+
+	update.ID = reservation.ID
+	update.From = reservation.Status
+	if err := s.reservationsRepo.UpdateStatus(ctx, update); err != nil {
+		return nil, fmt.Errorf("updating reservation status in repository: %w", err)
 	}
 
-	if err := s.reservationsRepo.SetStatus(ctx, id, bikerental.ReservationStatusCanceled); err != nil {
-		return fmt.Errorf("updating reservation status in repository: %w", err)
+	reservation.Status = update.To
+	if !update.PickedUpAt.IsZero() {
+		reservation.PickedUpAt = update.PickedUpAt
 	}
-	return nil
+	if !update.ReturnedAt.IsZero() {
+		reservation.ReturnedAt = update.ReturnedAt
+	}
+	return reservation, nil
+}
+
+// CheckDiscount returns discount that would be applied to a reservation.
+// It doesn't check bike availability and doesn't create any reservation.
+func (s *Service) CheckDiscount(ctx context.Context, req bikerental.CheckDiscountRequest) (*bikerental.CheckDiscountResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.CheckDiscount", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	bike, err := s.fetchRealBike(ctx, req.BikeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the customer exists, we want to have its real data.
+	customer, err := s.updateCustomerData(ctx, req.Customer)
+	if err != nil {
+		return nil, err
+	}
+
+	value := s.calculateReservationValue(*bike, req.StartTime, req.EndTime)
+
+	discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
+		Customer:         customer,
+		Location:         req.Location,
+		Bike:             *bike,
+		ReservationValue: value,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking available discounts: %w", err)
+	}
+
+	return &bikerental.CheckDiscountResponse{
+		ReservationValue: value,
+		Discount:         discountResp.Discount,
+		Candidates:       discountResp.Candidates,
+	}, nil
 }
 
 func (s *Service) fetchRealBike(ctx context.Context, bikeID string) (*bikerental.Bike, error) {
@@ -191,9 +721,44 @@ func (s *Service) fetchRealBike(ctx context.Context, bikeID string) (*bikerental
 	return existingBike, nil
 }
 
+// checkBikeRentable returns non empty reason if the bike can't be rented.
+// Bike has to be in service, and if location is not nil, it has to be at a station near that location.
+func (s *Service) checkBikeRentable(ctx context.Context, bike bikerental.Bike, location *bikerental.Location) (string, error) {
+	if !bike.InService() {
+		return fmt.Sprintf("bike is out of service, status: %s", bike.Status), nil
+	}
+	if location == nil {
+		return "", nil
+	}
+
+	if bike.StationID == "" {
+		return "bike is not assigned to any station", nil
+	}
+	station, err := s.stationService.Get(ctx, bike.StationID)
+	if err != nil {
+		return "", fmt.Errorf("fetching bike station: %w", err)
+	}
+	if d := station.Location.DistanceTo(*location); d > s.maxStationDistance {
+		return fmt.Sprintf("bike is at station '%s', %.0fm from requested location", station.Name, d), nil
+	}
+	return "", nil
+}
+
 func (s *Service) updateCustomerData(ctx context.Context, customer bikerental.Customer) (bikerental.Customer, error) {
 	if customer.ID == "" {
-		return customer, nil
+		// Returning customers may not know their id, so we look them up by email.
+		customer.Email = bikerental.NormalizeEmail(customer.Email)
+		if customer.Email == "" {
+			return customer, nil
+		}
+		existingCustomer, err := s.customersRepo.GetByEmail(ctx, customer.Email)
+		if err != nil {
+			if app.IsNotFoundError(err) {
+				return customer, nil
+			}
+			return customer, fmt.Errorf("checking customer in repository: %w", err)
+		}
+		return *existingCustomer, nil
 	}
 
 	existingCustomer, err := s.customersRepo.Get(ctx, customer.ID)
@@ -0,0 +1,121 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// UpdateReservation changes time range or bike of approved or pending reservation.
+// Reservation value and discount are recalculated. Promo code redeemed with the reservation is applied again.
+// Freed time range is offered to the oldest fitting waitlist entry.
+// Like CreateReservation, it returns valid response with rejected status when the change is not possible,
+// and then the reservation is left unchanged.
+// Returns app.ErrNotFound if reservation doesn't exist, and app.ConflictError if it can't be modified.
+func (s *Service) UpdateReservation(ctx context.Context, req bikerental.UpdateReservationRequest) (*bikerental.UpdateReservationResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.UpdateReservation", app.AttrBikeID(req.BikeID), app.AttrReservationID(req.ID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	reservation, err := s.getBikeReservation(ctx, req.BikeID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	switch reservation.Status {
+	case bikerental.ReservationStatusApproved, bikerental.ReservationStatusPending:
+	default:
+		return nil, app.NewConflictError(fmt.Sprintf("reservation with status '%s' can't be modified", reservation.Status))
+	}
+	if reservation.HoldExpired(time.Now()) {
+		return nil, app.NewConflictError("reservation hold has expired")
+	}
+
+	bikeID := req.NewBikeID
+	if bikeID == "" {
+		bikeID = reservation.Bike.ID
+	}
+	bike, err := s.fetchRealBike(ctx, bikeID)
+	if err != nil {
+		if app.IsNotFoundError(err) {
+			return &bikerental.UpdateReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: fmt.Sprintf("bike with id '%s' does not exists", bikeID),
+			}, nil
+		}
+		return nil, err
+	}
+	reason, err := s.checkBikeRentable(ctx, *bike, &req.Location)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return &bikerental.UpdateReservationResponse{
+			Status: bikerental.ReservationStatusRejected,
+			Reason: reason,
+		}, nil
+	}
+
+	customer, err := s.customersRepo.Get(ctx, reservation.Customer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching customer from repository: %w", err)
+	}
+
+	value := s.calculateReservationValue(*bike, req.StartTime, req.EndTime)
+	discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
+		Customer:         *customer,
+		Location:         req.Location,
+		Bike:             *bike,
+		ReservationValue: value,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking available discounts: %w", err)
+	}
+
+	discount := discountResp.Discount
+	// Promo code was already redeemed, so it's applied without checking its limits again.
+	if reservation.PromoCode != "" {
+		promo, err := s.promoCodeService.Get(ctx, reservation.PromoCode)
+		if err != nil {
+			return nil, fmt.Errorf("fetching promo code: %w", err)
+		}
+		discount, _ = promo.Apply(value, discount)
+	}
+
+	span.SetAttributes(app.AttrDiscountRule(discount.Rule))
+
+	update := *reservation
+	update.Customer = *customer
+	update.Bike = *bike
+	update.StartTime = req.StartTime
+	update.EndTime = req.EndTime
+	update.TotalValue = value - discount.Amount
+	update.AppliedDiscount = discount.Amount
+	update.DiscountRule = discount.Rule
+
+	updated, promoted, err := s.reservationsRepo.Update(ctx, update)
+	if err != nil {
+		if errors.Is(err, bikerental.ErrBikeNotAvailable) {
+			return &bikerental.UpdateReservationResponse{
+				Status: bikerental.ReservationStatusRejected,
+				Reason: bikerental.ErrBikeNotAvailable.Error(),
+			}, nil
+		}
+		return nil, fmt.Errorf("updating reservation in repository: %w", err)
+	}
+
+	return &bikerental.UpdateReservationResponse{
+		Status:          updated.Status,
+		Reservation:     updated,
+		PriceDifference: updated.TotalValue - reservation.TotalValue,
+		Promoted:        s.promoted(ctx, promoted),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// JoinWaitlist enqueues reservation request for a bike that is not available in requested time range.
+// Reservation value and discount are calculated now, and used when the entry is promoted.
+// Returns app.ConflictError if the bike is available, so reservation can be created right away.
+func (s *Service) JoinWaitlist(ctx context.Context, req bikerental.CreateReservationRequest) (*bikerental.WaitlistEntry, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.JoinWaitlist", app.AttrBikeID(req.BikeID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.PromoCode != "" {
+		return nil, app.NewValidationError("promo codes can't be used on waitlist")
+	}
+
+	bike, err := s.fetchRealBike(ctx, req.BikeID)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := s.checkBikeRentable(ctx, *bike, &req.Location)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		return nil, app.NewValidationError(reason)
+	}
+
+	available, err := s.GetBikeAvailability(ctx, bike.ID, req.StartTime, req.EndTime, nil)
+	if err != nil {
+		return nil, fmt.Errorf("checking bike availability: %w", err)
+	}
+	if available {
+		return nil, app.NewConflictError("bike is available in requested time range")
+	}
+
+	customer, err := s.updateCustomerData(ctx, req.Customer)
+	if err != nil {
+		return nil, err
+	}
+
+	value := s.calculateReservationValue(*bike, req.StartTime, req.EndTime)
+	discountResp, err := s.discountService.CalculateDiscount(ctx, bikerental.DiscountRequest{
+		Customer:         customer,
+		Location:         req.Location,
+		Bike:             *bike,
+		ReservationValue: value,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking available discounts: %w", err)
+	}
+
+	entry, err := s.waitlistRepo.Create(ctx, bikerental.WaitlistEntry{
+		ID:              uuid.NewString(),
+		Status:          bikerental.WaitlistStatusWaiting,
+		Customer:        customer,
+		BikeID:          bike.ID,
+		Location:        req.Location,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		TotalValue:      value - discountResp.Discount.Amount,
+		AppliedDiscount: discountResp.Discount.Amount,
+		DiscountRule:    discountResp.Discount.Rule,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating waitlist entry in repository: %w", err)
+	}
+	return entry, nil
+}
+
+// ListWaitlist returns all waitlist entries for a bike, sorted by creation time.
+func (s *Service) ListWaitlist(ctx context.Context, bikeID string) ([]bikerental.WaitlistEntry, error) {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.ListWaitlist", app.AttrBikeID(bikeID))
+	defer span.End()
+
+	if _, err := uuid.Parse(bikeID); err != nil {
+		return nil, app.NewValidationError("bike id is invalid")
+	}
+
+	entries, err := s.waitlistRepo.List(ctx, bikeID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching waitlist entries from repository: %w", err)
+	}
+	return entries, nil
+}
+
+// WithdrawWaitlistEntry removes waiting entry from the waitlist.
+// Returns app.ErrNotFound if entry doesn't exist, and app.ConflictError if it was already promoted or withdrawn.
+func (s *Service) WithdrawWaitlistEntry(ctx context.Context, bikeID string, id string) error {
+	ctx, span := s.tracer.Start(ctx, "reservation.Service.WithdrawWaitlistEntry", app.AttrBikeID(bikeID))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return app.NewValidationError("invalid id")
+	}
+
+	entry, err := s.waitlistRepo.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("fetching waitlist entry from repository: %w", err)
+	}
+	// If the bike id doesn't match it's basically the same as invalid id.
+	if entry.BikeID != bikeID {
+		return app.ErrNotFound
+	}
+
+	if err := s.waitlistRepo.Withdraw(ctx, id); err != nil {
+		return fmt.Errorf("withdrawing waitlist entry in repository: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package bikerental
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+// Station represents a rental station, where bikes are picked up and returned.
+type Station struct {
+	ID       string
+	Name     string
+	Location Location
+
+	// Capacity is a max number of bikes assigned to the station.
+	Capacity int
+}
+
+// Validate validates station data.
+func (s *Station) Validate() error {
+	if s.Name == "" {
+		return app.NewValidationError("empty name")
+	}
+	if err := s.Location.Validate(); err != nil {
+		return fmt.Errorf("invalid location: %w", err)
+	}
+	if s.Capacity <= 0 {
+		return app.NewValidationError("capacity has to be positive")
+	}
+
+	return nil
+}
+
+// StationService manages rental stations.
+type StationService interface {
+	List(context.Context) ([]Station, error)
+	Get(ctx context.Context, id string) (*Station, error)
+	Add(context.Context, Station) (*Station, error)
+	Update(ctx context.Context, id string, s Station) error
+	Delete(ctx context.Context, id string) error
+}
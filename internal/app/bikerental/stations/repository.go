@@ -0,0 +1,19 @@
+package stations
+
+import (
+	"context"
+
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Repository can manage station data.
+type Repository interface {
+	List(context.Context) ([]bikerental.Station, error)
+	Get(ctx context.Context, id string) (*bikerental.Station, error)
+	Create(context.Context, bikerental.Station) error
+	Update(ctx context.Context, id string, s bikerental.Station) error
+
+	// Delete deletes station by id.
+	// Returns app.ConflictError if any bike is assigned to the station.
+	Delete(ctx context.Context, id string) error
+}
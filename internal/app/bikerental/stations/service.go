@@ -0,0 +1,97 @@
+package stations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/nglogic/go-application-guide/internal/app/bikerental"
+)
+
+// Service provides methods for managing rental stations.
+type Service struct {
+	repository Repository
+}
+
+// NewService creates new service instance.
+func NewService(stationRepo Repository) (*Service, error) {
+	if stationRepo == nil {
+		return nil, errors.New("empty station repository")
+	}
+	return &Service{
+		repository: stationRepo,
+	}, nil
+}
+
+// List returns all stations.
+func (s *Service) List(ctx context.Context) ([]bikerental.Station, error) {
+	ss, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching stations from repository: %w", err)
+	}
+	return ss, nil
+}
+
+// Get returns a station by id.
+func (s *Service) Get(ctx context.Context, id string) (*bikerental.Station, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, app.NewValidationError("invalid id")
+	}
+
+	st, err := s.repository.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("fetching station from repository: %w", err)
+	}
+	return st, nil
+}
+
+// Add adds a new station.
+// Returns added station with new id.
+func (s *Service) Add(ctx context.Context, st bikerental.Station) (*bikerental.Station, error) {
+	if st.ID != "" {
+		return nil, app.NewValidationError("can't add new station with id")
+	}
+	if err := st.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid station data: %w", err)
+	}
+
+	st.ID = uuid.NewString()
+	if err := s.repository.Create(ctx, st); err != nil {
+		return nil, fmt.Errorf("adding station to repository: %w", err)
+	}
+
+	return &st, nil
+}
+
+// Update updates existing station by id.
+func (s *Service) Update(ctx context.Context, id string, st bikerental.Station) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return app.NewValidationError("invalid id")
+	}
+	if err := st.Validate(); err != nil {
+		return fmt.Errorf("invalid station data: %w", err)
+	}
+
+	st.ID = id
+	if err := s.repository.Update(ctx, id, st); err != nil {
+		return fmt.Errorf("updating station in repository: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes existing station. If station doesn't exists, returns nil.
+// Stations with assigned bikes can't be deleted, app.ConflictError is returned in that case.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return app.NewValidationError("invalid id")
+	}
+	if err := s.repository.Delete(ctx, id); err != nil {
+		if app.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("deleting station in repository: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package bikerental
+
+import "time"
+
+// WaitlistStatus describes waitlist entry status.
+type WaitlistStatus string
+
+// Waitlist entry statuses.
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "waiting"
+	WaitlistStatusPromoted  WaitlistStatus = "promoted"
+	WaitlistStatusWithdrawn WaitlistStatus = "withdrawn"
+)
+
+// WaitlistEntry is a reservation request waiting for the bike to become available.
+// When overlapping reservation is canceled, the oldest fitting entry is promoted to approved reservation.
+type WaitlistEntry struct {
+	ID        string
+	Status    WaitlistStatus
+	Customer  Customer
+	BikeID    string
+	Location  Location
+	StartTime time.Time
+	EndTime   time.Time
+
+	// TotalValue, AppliedDiscount and DiscountRule are calculated when joining the waitlist,
+	// and used for the reservation after promotion.
+	TotalValue      int
+	AppliedDiscount int
+	DiscountRule    string
+
+	// ReservationID is set when the entry is promoted.
+	ReservationID string
+
+	CreatedAt time.Time
+}
+
+// NotificationKindWaitlistPromoted is a kind of notification recorded when waitlist entry is promoted to reservation.
+const NotificationKindWaitlistPromoted = "waitlist_promoted"
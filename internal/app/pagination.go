@@ -0,0 +1,48 @@
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Page size limits for list requests.
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// PageSize returns page size to use for requested size.
+// Zero means default page size. Returns ValidationError if size is out of range.
+func PageSize(requested int) (int, error) {
+	if requested < 0 || requested > MaxPageSize {
+		return 0, NewValidationError(fmt.Sprintf("page size has to be in range [0, %d]", MaxPageSize))
+	}
+	if requested == 0 {
+		return DefaultPageSize, nil
+	}
+	return requested, nil
+}
+
+// EncodePageToken encodes keyset cursor into opaque page token.
+// Cursor has to be json serializable.
+func EncodePageToken(cursor interface{}) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("encoding page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodePageToken decodes page token created by EncodePageToken into `cursor`.
+// Returns ValidationError if token is invalid.
+func DecodePageToken(token string, cursor interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return NewValidationError("invalid page token")
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return NewValidationError("invalid page token")
+	}
+	return nil
+}
@@ -10,6 +10,9 @@ const (
 	ctxTraceIDKeyKey ctxTraceIDKeyType = iota
 )
 
+// TraceIDHeader is a header returned to the caller with trace id of handled request.
+const TraceIDHeader = "trace-id"
+
 // TraceIDFromCtx returns trace id from context.
 // If id is not present in context, returns empty string.
 func TraceIDFromCtx(ctx context.Context) string {
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+)
+
+// Span attribute keys used across the app.
+const (
+	AttrKeyBikeID        = "bike.id"
+	AttrKeyReservationID = "reservation.id"
+	AttrKeyDiscountRule  = "discount.rule"
+)
+
+// Attribute is a key-value pair describing a span.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// AttrBikeID returns span attribute with bike id.
+func AttrBikeID(id string) Attribute {
+	return Attribute{Key: AttrKeyBikeID, Value: id}
+}
+
+// AttrReservationID returns span attribute with reservation id.
+func AttrReservationID(id string) Attribute {
+	return Attribute{Key: AttrKeyReservationID, Value: id}
+}
+
+// AttrDiscountRule returns span attribute with id of applied discount rule.
+func AttrDiscountRule(rule string) Attribute {
+	return Attribute{Key: AttrKeyDiscountRule, Value: rule}
+}
+
+// Tracer starts spans of app operations.
+// It's implemented by tracing adapter, so app services don't depend on tracing library.
+type Tracer interface {
+	// Start starts new span as a child of span from ctx.
+	// Returned context contains the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+
+	// SpanFromContext returns current span from ctx.
+	// If ctx has no span, returned span is a noop.
+	SpanFromContext(ctx context.Context) Span
+}
+
+// Span is a single traced operation.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	AddEvent(name string, attrs ...Attribute)
+	End()
+}
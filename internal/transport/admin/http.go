@@ -0,0 +1,58 @@
+package admin
+
+import (
+	context "context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// HTTP server default timeouts.
+const (
+	readTimeout       = 5 * time.Second
+	readHeaderTimeout = 3 * time.Second
+	writeTimeout      = 10 * time.Second
+	idleTimeout       = 30 * time.Second
+)
+
+// RunServer starts admin http server, exposing metrics at /metrics.
+// It listens on a separate address than public servers, so it doesn't have to be reachable from outside.
+// Server is gracefully shut down on context cancellation.
+func RunServer(
+	ctx context.Context,
+	log logrus.FieldLogger,
+	metricsHandler http.Handler,
+	addr string,
+) error {
+	if metricsHandler == nil {
+		return errors.New("empty metrics handler")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metricsHandler)
+
+	s := http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadTimeout:       readTimeout,
+		ReadHeaderTimeout: readHeaderTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		log.Info("admin server: shutting down")
+		if err := s.Shutdown(context.Background()); err != nil {
+			log.Errorf("admin server: failed to shutdown: %v", err)
+		}
+	}()
+
+	log.Infof("admin server: listening on %s", addr)
+	if err := s.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
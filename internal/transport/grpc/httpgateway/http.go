@@ -31,15 +31,18 @@ func RunServer(
 	srv bikerentalv1.BikeRentalServiceServer,
 	addr string,
 ) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithMetadata(RecordRPCMethod))
 	if err := bikerentalv1.RegisterBikeRentalServiceHandlerServer(ctx, mux, srv); err != nil {
 		return fmt.Errorf("registering http handlers for server: %w", err)
 	}
 
+	// Middlewares are applied in reverse order, so requests go through trace id, log context, metrics and recovery.
+	// Recovery has to run inside metrics, so recovered panics are recorded with 500 status code.
 	var handler http.Handler = mux
+	handler = HandlerWithRecovery(handler, log, met)
+	handler = HandlerWithMetrics(handler, met)
 	handler = HandlerWithLogCtx(handler)
 	handler = HandlerWithTraceID(handler)
-	handler = HandlerWithMetrics(handler, met)
 
 	// See this great explanation on http timeouts:
 	// https://blog.cloudflare.com/the-complete-guide-to-golang-net-http-timeouts/
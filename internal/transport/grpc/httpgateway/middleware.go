@@ -1,29 +1,67 @@
 package httpgateway
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"runtime/debug"
+	"strconv"
 	"time"
 
 	"github.com/nglogic/go-application-guide/internal/adapter/metrics"
 
 	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
 )
 
-// HandlerWithTraceID wraps handler with middleware generating trace id for each request.
-// If trace id is present in headers, it will be preserved. We try to discover incoming trace ids based on w3 standard:
+var tracer = otel.Tracer("github.com/nglogic/go-application-guide/internal/transport/grpc/httpgateway")
+
+// propagator reads W3C trace context from incoming request headers.
+var propagator = propagation.TraceContext{}
+
+// internalErrorBody is a response body for recovered panics, in the same format as grpc gateway errors.
+const internalErrorBody = `{"code":13,"message":"internal error","details":[]}`
+
+// HandlerWithTraceID wraps handler with middleware tracing each request.
+// Trace context is read from W3C traceparent and tracestate headers, or a new trace is started:
 // https://www.w3.org/TR/trace-context/#trace-context-http-headers-format
+// Every request is handled in a new server span, and trace id is returned to the caller in the response header.
+// Span is named after grpc method handling the request, recorded by RecordRPCMethod,
+// so paths with different resource ids share the span name. Raw path is kept only in http.target attribute.
 func HandlerWithTraceID(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		traceID := r.Header.Get("trace-id")
-		if traceID == "" {
-			traceID = fmt.Sprintf("%x", uuid.New())
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		// Requests not routed to any grpc method keep this generic name.
+		ctx, span := tracer.Start(ctx, "HTTP "+r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.method", r.Method), attribute.String("http.target", r.URL.Path)),
+		)
+		defer span.End()
+
+		if sc := span.SpanContext(); sc.IsValid() {
+			ctx = app.CtxWithTraceID(ctx, sc.TraceID().String())
+		} else {
+			ctx = app.CtxWithTraceID(ctx, fmt.Sprintf("%x", uuid.New()))
 		}
 
-		ctx := app.CtxWithTraceID(r.Context(), traceID)
+		w.Header().Set(app.TraceIDHeader, app.TraceIDFromCtx(ctx))
+
+		wrappedResponse := NewResponseWrapper(w)
 		r = r.Clone(ctx)
-		h.ServeHTTP(w, r)
+		h.ServeHTTP(wrappedResponse, r)
+
+		span.SetAttributes(attribute.Int("http.status_code", wrappedResponse.StatusCode))
+		if wrappedResponse.StatusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(wrappedResponse.StatusCode))
+		}
 	})
 }
 
@@ -39,21 +77,70 @@ func HandlerWithLogCtx(h http.Handler) http.Handler {
 	})
 }
 
-// HandlerWithMetrics wraps handler with middleware adding metrics details.
+// HandlerWithMetrics wraps handler with middleware counting requests and observing their durations.
+// Requests are labeled with grpc method handling them, so metrics of all paths of the same endpoint are combined.
+// Method is recorded by RecordRPCMethod, that has to be registered in gateway mux.
 func HandlerWithMetrics(h http.Handler, m metrics.Provider) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
+		method := unknownRPCMethod
+		ctx := context.WithValue(r.Context(), rpcMethodKey{}, &method)
 
 		start := time.Now()
 
 		wrappedResponse := NewResponseWrapper(w)
 		defer func() {
-			m.Count(r.Method, r.URL.Path)
-			m.Count(r.Method, r.URL.Path, fmt.Sprintf("%d", wrappedResponse.StatusCode))
-			m.Duration(time.Since(start), r.Method, r.URL.Path)
+			labels := metrics.Labels{
+				metrics.LabelMethod:     method,
+				metrics.LabelStatusCode: strconv.Itoa(wrappedResponse.StatusCode),
+			}
+			m.Count("http_requests", labels)
+			m.Duration("http_request", time.Since(start), labels)
 		}()
 
 		r = r.Clone(ctx)
 		h.ServeHTTP(wrappedResponse, r)
 	})
 }
+
+// unknownRPCMethod is a method label of requests not routed to any grpc method.
+const unknownRPCMethod = "unknown"
+
+type rpcMethodKey struct{}
+
+// RecordRPCMethod is a gateway mux metadata annotator, passing name of called grpc method to HandlerWithMetrics,
+// and naming the server span started by HandlerWithTraceID after it.
+// It doesn't add any metadata.
+func RecordRPCMethod(ctx context.Context, _ *http.Request) metadata.MD {
+	method, ok := runtime.RPCMethod(ctx)
+	if !ok {
+		return nil
+	}
+	if p, ok := ctx.Value(rpcMethodKey{}).(*string); ok {
+		*p = method
+	}
+	span := trace.SpanFromContext(ctx)
+	span.SetName(method)
+	span.SetAttributes(attribute.String("rpc.method", method))
+	return nil
+}
+
+// HandlerWithRecovery wraps handler with middleware recovering from panics.
+// Panic is logged with stack trace, counted in metrics, and returned to the client as internal server error.
+func HandlerWithRecovery(h http.Handler, log logrus.FieldLogger, m metrics.Provider) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if p := recover(); p != nil {
+				m.Count("http_panics", nil)
+				app.AugmentLogFromCtx(r.Context(), log).
+					WithField("panic", p).
+					Errorf("http server: panic recovered\n%s", debug.Stack())
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(internalErrorBody))
+			}
+		}()
+
+		h.ServeHTTP(w, r)
+	})
+}
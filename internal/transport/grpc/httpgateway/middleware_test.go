@@ -0,0 +1,124 @@
+package httpgateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/metrics"
+	"github.com/nglogic/go-application-guide/internal/app"
+)
+
+type recordingMetrics struct {
+	mu     sync.Mutex
+	counts map[string][]metrics.Labels
+}
+
+func (m *recordingMetrics) Count(name string, labels metrics.Labels) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counts == nil {
+		m.counts = map[string][]metrics.Labels{}
+	}
+	m.counts[name] = append(m.counts[name], labels)
+}
+
+func (m *recordingMetrics) Add(string, float64, metrics.Labels) {}
+
+func (m *recordingMetrics) Duration(string, time.Duration, metrics.Labels) {}
+
+func TestHandlerWithMetricsRecordsRecoveredPanic(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	met := &recordingMetrics{}
+
+	var handler http.Handler = http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+	handler = HandlerWithRecovery(handler, log, met)
+	handler = HandlerWithMetrics(handler, met)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("response status code = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+	requests := met.counts["http_requests"]
+	if len(requests) != 1 {
+		t.Fatalf("recorded %d requests, want 1", len(requests))
+	}
+	if got := requests[0][metrics.LabelStatusCode]; got != "500" {
+		t.Fatalf("recorded status code = %q, want \"500\"", got)
+	}
+	if len(met.counts["http_panics"]) != 1 {
+		t.Fatalf("recorded %d panics, want 1", len(met.counts["http_panics"]))
+	}
+}
+
+func TestHandlerWithTraceIDContinuesCallerTrace(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	var ctxTraceID string
+	handler := HandlerWithTraceID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctxTraceID = app.TraceIDFromCtx(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if ctxTraceID != traceID {
+		t.Fatalf("trace id in context = %q, want %q", ctxTraceID, traceID)
+	}
+	if got := rec.Header().Get(app.TraceIDHeader); got != traceID {
+		t.Fatalf("trace id header = %q, want %q", got, traceID)
+	}
+}
+
+func TestHandlerWithTraceIDNamesSpanByRPCMethod(t *testing.T) {
+	const method = "/bikerental.v1.BikeRentalService/GetBike"
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+	otel.SetTracerProvider(tp)
+
+	mux := runtime.NewServeMux(runtime.WithMetadata(RecordRPCMethod))
+	handler := HandlerWithTraceID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// Generated gateway handlers annotate the context this way, calling metadata annotators.
+		if _, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, method); err != nil {
+			t.Errorf("annotating context: %v", err)
+		}
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/bikes/b7f1c6d2", nil))
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("exported %d spans, want 1", len(spans))
+	}
+	if spans[0].Name != method {
+		t.Fatalf("span name = %q, want %q", spans[0].Name, method)
+	}
+	var target string
+	for _, a := range spans[0].Attributes {
+		if a.Key == "http.target" {
+			target = a.Value.AsString()
+		}
+	}
+	if target != "/v1/bikes/b7f1c6d2" {
+		t.Fatalf("http.target = %q, want request path", target)
+	}
+}
@@ -13,22 +13,23 @@ type ResponseWrapper struct {
 // NewResponseWrapper returns a new wrapper with the response.
 func NewResponseWrapper(response http.ResponseWriter) *ResponseWrapper {
 	return &ResponseWrapper{
-		response: response,
+		StatusCode: http.StatusOK,
+		response:   response,
 	}
 }
 
 // Header implements the ResponseWriter interface.
-func (r ResponseWrapper) Header() http.Header {
+func (r *ResponseWrapper) Header() http.Header {
 	return r.response.Header()
 }
 
 // Write implements the ResponseWriter interface.
-func (r ResponseWrapper) Write(bytes []byte) (int, error) {
+func (r *ResponseWrapper) Write(bytes []byte) (int, error) {
 	return r.response.Write(bytes)
 }
 
 // WriteHeader implements the ResponseWriter interface.
-func (r ResponseWrapper) WriteHeader(statusCode int) {
+func (r *ResponseWrapper) WriteHeader(statusCode int) {
 	r.StatusCode = statusCode
 	r.response.WriteHeader(statusCode)
 }
@@ -2,19 +2,47 @@ package grpc
 
 import (
 	context "context"
+	"fmt"
+	"runtime/debug"
 	"time"
 
 	"github.com/nglogic/go-application-guide/internal/adapter/metrics"
 
 	"github.com/google/uuid"
+	"github.com/nglogic/go-application-guide/internal/adapter/tracing"
 	"github.com/nglogic/go-application-guide/internal/app"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	status "google.golang.org/grpc/status"
 )
 
-// TraceIDUnaryServerInterceptor returns a new unary server interceptor for generating trace id.
-func TraceIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		ctx = app.CtxWithTraceID(ctx, uuid.NewString())
+var tracer = otel.Tracer("github.com/nglogic/go-application-guide/internal/transport/grpc")
+
+// propagator reads W3C trace context from incoming request metadata.
+var propagator = propagation.TraceContext{}
+
+// TraceIDUnaryServerInterceptor returns a new unary server interceptor for tracing requests.
+// Trace context is read from W3C traceparent and tracestate metadata, or a new trace is started,
+// and every request is handled in a new server span.
+// Trace id is returned to the caller in response headers and trailers.
+func TraceIDUnaryServerInterceptor(log logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer func() { tracing.EndSpan(span, err) }()
+
+		md := metadata.Pairs(app.TraceIDHeader, app.TraceIDFromCtx(ctx))
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			app.AugmentLogFromCtx(ctx, log).Warnf("grpc server: setting trace id header: %v", err)
+		}
+		if err := grpc.SetTrailer(ctx, md); err != nil {
+			app.AugmentLogFromCtx(ctx, log).Warnf("grpc server: setting trace id trailer: %v", err)
+		}
 
 		return handler(ctx, req)
 	}
@@ -29,20 +57,200 @@ func LogCtxUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-// MetricsUnaryServerInterceptor returns a new unary server interceptor adding metrics to context for logging.
+// RecoveryUnaryServerInterceptor returns a new unary server interceptor recovering from panics in handlers.
+// Panic is logged with stack trace, counted in metrics, and returned to the client as internal error.
+// Panic unwinds past metrics and access log interceptors, so the request is also recorded in request metrics
+// and access log here.
+func RecoveryUnaryServerInterceptor(log logrus.FieldLogger, m metrics.Provider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		start := time.Now()
+		defer func() {
+			if p := recover(); p != nil {
+				err = recoverPanic(ctx, log, m, info.FullMethod, p, time.Since(start))
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// MetricsUnaryServerInterceptor returns a new unary server interceptor counting requests and observing their durations.
 func MetricsUnaryServerInterceptor(m metrics.Provider) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		start := time.Now()
 
-		m.Count("grpc.invocation", info.FullMethod)
-
 		resp, err := handler(ctx, req)
-		if err != nil {
-			m.Count("grpc.errors_count", info.FullMethod)
+
+		labels := metrics.Labels{
+			metrics.LabelMethod:   info.FullMethod,
+			metrics.LabelGRPCCode: status.Code(err).String(),
 		}
+		m.Count("grpc_requests", labels)
+		m.Duration("grpc_request", time.Since(start), labels)
+
+		return resp, err
+	}
+}
 
-		m.Duration(time.Since(start), info.FullMethod)
+// AccessLogUnaryServerInterceptor returns a new unary server interceptor logging every handled request.
+func AccessLogUnaryServerInterceptor(log logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logAccess(ctx, log, status.Code(err), time.Since(start))
 
 		return resp, err
 	}
 }
+
+// TraceIDStreamServerInterceptor returns a new stream server interceptor for tracing requests.
+// It works like TraceIDUnaryServerInterceptor.
+func TraceIDStreamServerInterceptor(log logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer func() { tracing.EndSpan(span, err) }()
+
+		md := metadata.Pairs(app.TraceIDHeader, app.TraceIDFromCtx(ctx))
+		if err := ss.SetHeader(md); err != nil {
+			app.AugmentLogFromCtx(ctx, log).Warnf("grpc server: setting trace id header: %v", err)
+		}
+		ss.SetTrailer(md)
+
+		return handler(srv, &serverStreamWithCtx{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// LogCtxStreamServerInterceptor returns a new stream server interceptor adding request information to context for logging.
+func LogCtxStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := app.CtxWithLogField(ss.Context(), "grpc.method", info.FullMethod)
+
+		return handler(srv, &serverStreamWithCtx{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// RecoveryStreamServerInterceptor returns a new stream server interceptor recovering from panics in handlers.
+// It works like RecoveryUnaryServerInterceptor.
+func RecoveryStreamServerInterceptor(log logrus.FieldLogger, m metrics.Provider) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		defer func() {
+			if p := recover(); p != nil {
+				err = recoverPanic(ss.Context(), log, m, info.FullMethod, p, time.Since(start))
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// MetricsStreamServerInterceptor returns a new stream server interceptor adding metrics.
+func MetricsStreamServerInterceptor(m metrics.Provider) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		labels := metrics.Labels{
+			metrics.LabelMethod:   info.FullMethod,
+			metrics.LabelGRPCCode: status.Code(err).String(),
+		}
+		m.Count("grpc_requests", labels)
+		m.Duration("grpc_request", time.Since(start), labels)
+
+		return err
+	}
+}
+
+// AccessLogStreamServerInterceptor returns a new stream server interceptor logging every handled stream.
+func AccessLogStreamServerInterceptor(log logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		logAccess(ss.Context(), log, status.Code(err), time.Since(start))
+
+		return err
+	}
+}
+
+// startServerSpan starts server span for the grpc method, continuing trace context from incoming metadata.
+func startServerSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = propagator.Extract(ctx, metadataCarrier(md))
+
+	ctx, span := tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", method)),
+	)
+	if sc := span.SpanContext(); sc.IsValid() {
+		ctx = app.CtxWithTraceID(ctx, sc.TraceID().String())
+	} else {
+		ctx = app.CtxWithTraceID(ctx, fmt.Sprintf("%x", uuid.New()))
+	}
+	return ctx, span
+}
+
+// metadataCarrier adapts grpc metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+// Get returns the first value of the key.
+func (c metadataCarrier) Get(key string) string {
+	if vals := metadata.MD(c).Get(key); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+// Set sets the value of the key.
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys lists the keys stored in metadata.
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// serverStreamWithCtx overrides context of wrapped server stream.
+type serverStreamWithCtx struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns overridden stream context.
+func (s *serverStreamWithCtx) Context() context.Context {
+	return s.ctx
+}
+
+// recoverPanic handles panic recovered after `duration` of handling request, and returns error for the client.
+func recoverPanic(ctx context.Context, log logrus.FieldLogger, m metrics.Provider, method string, p interface{}, duration time.Duration) error {
+	m.Count("grpc_panics", metrics.Labels{metrics.LabelMethod: method})
+	app.AugmentLogFromCtx(ctx, log).
+		WithField("panic", p).
+		Errorf("grpc server: panic recovered\n%s", debug.Stack())
+
+	labels := metrics.Labels{
+		metrics.LabelMethod:   method,
+		metrics.LabelGRPCCode: codes.Internal.String(),
+	}
+	m.Count("grpc_requests", labels)
+	m.Duration("grpc_request", duration, labels)
+	logAccess(ctx, log, codes.Internal, duration)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func logAccess(ctx context.Context, log logrus.FieldLogger, code codes.Code, duration time.Duration) {
+	app.AugmentLogFromCtx(ctx, log).
+		WithField("grpc.code", code.String()).
+		WithField("duration", duration.String()).
+		Info("grpc server: request handled")
+}
@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/nglogic/go-application-guide/internal/adapter/metrics"
+)
+
+type recordingMetrics struct {
+	mu     sync.Mutex
+	counts map[string][]metrics.Labels
+}
+
+func (m *recordingMetrics) Count(name string, labels metrics.Labels) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counts == nil {
+		m.counts = map[string][]metrics.Labels{}
+	}
+	m.counts[name] = append(m.counts[name], labels)
+}
+
+func (m *recordingMetrics) Add(string, float64, metrics.Labels) {}
+
+func (m *recordingMetrics) Duration(string, time.Duration, metrics.Labels) {}
+
+func TestRecoveryUnaryServerInterceptorRecordsRecoveredPanic(t *testing.T) {
+	const method = "/bikerental.v1.BikeRentalService/GetBike"
+	var logs bytes.Buffer
+	log := logrus.New()
+	log.SetOutput(&logs)
+	met := &recordingMetrics{}
+
+	// Interceptors are chained like in RunServer.
+	var handler grpc.UnaryHandler = func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	for _, interceptor := range []grpc.UnaryServerInterceptor{
+		AccessLogUnaryServerInterceptor(log),
+		MetricsUnaryServerInterceptor(met),
+		RecoveryUnaryServerInterceptor(log, met),
+	} {
+		next, interceptor := handler, interceptor
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	_, err := handler(context.Background(), nil)
+
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("error code = %v, want %v", status.Code(err), codes.Internal)
+	}
+	requests := met.counts["grpc_requests"]
+	if len(requests) != 1 {
+		t.Fatalf("recorded %d requests, want 1", len(requests))
+	}
+	if got := requests[0][metrics.LabelGRPCCode]; got != codes.Internal.String() {
+		t.Fatalf("recorded grpc code = %q, want %q", got, codes.Internal.String())
+	}
+	if got := requests[0][metrics.LabelMethod]; got != method {
+		t.Fatalf("recorded method = %q, want %q", got, method)
+	}
+	if len(met.counts["grpc_panics"]) != 1 {
+		t.Fatalf("recorded %d panics, want 1", len(met.counts["grpc_panics"]))
+	}
+	if n := strings.Count(logs.String(), "request handled"); n != 1 {
+		t.Fatalf("logged %d access log lines, want 1:\n%s", n, logs.String())
+	}
+	if !strings.Contains(logs.String(), "grpc.code=Internal") {
+		t.Fatalf("access log doesn't contain internal error code:\n%s", logs.String())
+	}
+}
@@ -1,16 +1,45 @@
 package grpc
 
 import (
+	"time"
+
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newAppBikeFromRequestData(data *bikerentalv1.BikeData) *bikerental.Bike {
 	return &bikerental.Bike{
-		ModelName:    data.ModelName,
-		Weight:       float64(data.Weight),
-		PricePerHour: int(data.PricePerHour),
+		ModelName:    data.GetModelName(),
+		Weight:       float64(data.GetWeight()),
+		PricePerHour: int(data.GetPricePerHour()),
+		SerialNumber: data.GetSerialNumber(),
+		StationID:    data.GetStationId(),
+		Status:       newAppBikeStatus(data.GetStatus()),
+	}
+}
+
+func newAppBikeStatus(s bikerentalv1.BikeStatus) bikerental.BikeStatus {
+	switch s {
+	case bikerentalv1.BikeStatus_BIKE_STATUS_AVAILABLE:
+		return bikerental.BikeStatusAvailable
+	case bikerentalv1.BikeStatus_BIKE_STATUS_MAINTENANCE:
+		return bikerental.BikeStatusMaintenance
+	case bikerentalv1.BikeStatus_BIKE_STATUS_RETIRED:
+		return bikerental.BikeStatusRetired
 	}
+	return bikerental.BikeStatusEmpty
+}
+
+func newAppStationFromRequestData(data *bikerentalv1.StationData) *bikerental.Station {
+	st := &bikerental.Station{
+		Name:     data.GetName(),
+		Capacity: int(data.GetCapacity()),
+	}
+	if l := newAppLocationFromRequest(data.GetLocation()); l != nil {
+		st.Location = *l
+	}
+	return st
 }
 
 func newAppCustomerFromRequest(rc *bikerentalv1.Customer) *bikerental.Customer {
@@ -18,24 +47,30 @@ func newAppCustomerFromRequest(rc *bikerentalv1.Customer) *bikerental.Customer {
 		return nil
 	}
 
-	data := rc.GetData()
-	ct := bikerental.CustomerTypeUnknown
-	switch data.GetType() {
-	case bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL:
-		ct = bikerental.CustomerTypeIndividual
-	case bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS:
-		ct = bikerental.CustomerTypeBusiness
-	}
+	c := newAppCustomerFromRequestData(rc.GetData())
+	c.ID = rc.Id
+	return c
+}
 
+func newAppCustomerFromRequestData(data *bikerentalv1.CustomerData) *bikerental.Customer {
 	return &bikerental.Customer{
-		ID:        rc.Id,
-		Type:      ct,
+		Type:      newAppCustomerType(data.GetType()),
 		FirstName: data.GetFirstName(),
 		Surname:   data.GetSurname(),
 		Email:     data.GetEmail(),
 	}
 }
 
+func newAppCustomerType(t bikerentalv1.CustomerType) bikerental.CustomerType {
+	switch t {
+	case bikerentalv1.CustomerType_CUSTOMER_TYPE_INDIVIDUAL:
+		return bikerental.CustomerTypeIndividual
+	case bikerentalv1.CustomerType_CUSTOMER_TYPE_BUSINESS:
+		return bikerental.CustomerTypeBusiness
+	}
+	return bikerental.CustomerTypeUnknown
+}
+
 func newAppLocationFromRequest(rl *bikerentalv1.Location) *bikerental.Location {
 	if rl == nil {
 		return nil
@@ -46,3 +81,28 @@ func newAppLocationFromRequest(rl *bikerentalv1.Location) *bikerental.Location {
 		Long: float64(rl.Long),
 	}
 }
+
+func newAppPromoCodeFromRequest(rpc *bikerentalv1.PromoCode) *bikerental.PromoCode {
+	if rpc == nil {
+		return nil
+	}
+
+	return &bikerental.PromoCode{
+		Code:                      rpc.Code,
+		Percent:                   rpc.Percent,
+		Amount:                    int(rpc.Amount),
+		ValidFrom:                 rpc.ValidFrom.AsTime(),
+		ValidTo:                   rpc.ValidTo.AsTime(),
+		MaxRedemptions:            int(rpc.MaxRedemptions),
+		MaxRedemptionsPerCustomer: int(rpc.MaxRedemptionsPerCustomer),
+		Stackable:                 rpc.Stackable,
+	}
+}
+
+// newAppOptionalTime returns zero time for nil timestamp.
+func newAppOptionalTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
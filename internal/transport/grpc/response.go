@@ -1,19 +1,26 @@
 package grpc
 
 import (
+	"time"
+
 	"github.com/nglogic/go-application-guide/internal/app/bikerental"
 	"github.com/nglogic/go-application-guide/pkg/api/bikerentalv1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func newListBikesResponse(bikes []bikerental.Bike) *bikerentalv1.ListBikesResponse {
-	respBikes := make([]*bikerentalv1.Bike, 0, len(bikes))
-	for i := range bikes {
-		respBikes = append(respBikes, newResponseBike(&bikes[i]))
+func newListBikesResponse(r *bikerental.ListBikesResponse) *bikerentalv1.ListBikesResponse {
+	if r == nil {
+		return nil
+	}
+
+	respBikes := make([]*bikerentalv1.Bike, 0, len(r.Bikes))
+	for i := range r.Bikes {
+		respBikes = append(respBikes, newResponseBike(&r.Bikes[i]))
 	}
 
 	return &bikerentalv1.ListBikesResponse{
-		Bikes: respBikes,
+		Bikes:         respBikes,
+		NextPageToken: r.NextPageToken,
 	}
 }
 
@@ -27,6 +34,63 @@ func newResponseBike(b *bikerental.Bike) *bikerentalv1.Bike {
 			ModelName:    b.ModelName,
 			Weight:       float32(b.Weight),
 			PricePerHour: int32(b.PricePerHour),
+			SerialNumber: b.SerialNumber,
+			StationId:    b.StationID,
+			Status:       newResponseBikeStatus(b.Status),
+		},
+	}
+}
+
+func newResponseBikeStatus(s bikerental.BikeStatus) bikerentalv1.BikeStatus {
+	switch s {
+	case bikerental.BikeStatusAvailable:
+		return bikerentalv1.BikeStatus_BIKE_STATUS_AVAILABLE
+	case bikerental.BikeStatusMaintenance:
+		return bikerentalv1.BikeStatus_BIKE_STATUS_MAINTENANCE
+	case bikerental.BikeStatusRetired:
+		return bikerentalv1.BikeStatus_BIKE_STATUS_RETIRED
+	}
+	return bikerentalv1.BikeStatus_BIKE_STATUS_UNKNOWN
+}
+
+func newSearchAvailableBikesResponse(bikes []bikerental.AvailableBike) *bikerentalv1.SearchAvailableBikesResponse {
+	respBikes := make([]*bikerentalv1.AvailableBike, 0, len(bikes))
+	for i := range bikes {
+		respBikes = append(respBikes, &bikerentalv1.AvailableBike{
+			Bike:     newResponseBike(&bikes[i].Bike),
+			Distance: bikes[i].Distance,
+		})
+	}
+
+	return &bikerentalv1.SearchAvailableBikesResponse{
+		Bikes: respBikes,
+	}
+}
+
+func newListStationsResponse(stations []bikerental.Station) *bikerentalv1.ListStationsResponse {
+	respStations := make([]*bikerentalv1.Station, 0, len(stations))
+	for i := range stations {
+		respStations = append(respStations, newResponseStation(&stations[i]))
+	}
+
+	return &bikerentalv1.ListStationsResponse{
+		Stations: respStations,
+	}
+}
+
+func newResponseStation(st *bikerental.Station) *bikerentalv1.Station {
+	if st == nil {
+		return nil
+	}
+	return &bikerentalv1.Station{
+		Id: st.ID,
+		Data: &bikerentalv1.StationData{
+			Name: st.Name,
+			Location: &bikerentalv1.Location{
+				Lat:  float32(st.Location.Lat),
+				Long: float32(st.Location.Long),
+			},
+			Capacity: int32(st.Capacity),
 		},
 	}
 }
@@ -43,6 +107,81 @@ func newCreateReservationResponse(r *bikerental.ReservationResponse) *bikerental
 	}
 }
 
+func newUpdateReservationResponse(r *bikerental.UpdateReservationResponse) *bikerentalv1.UpdateReservationResponse {
+	if r == nil {
+		return nil
+	}
+
+	return &bikerentalv1.UpdateReservationResponse{
+		Reservation:     newResponseReservation(r.Reservation),
+		Status:          newResponseReservationStatus(r.Status),
+		Reason:          r.Reason,
+		PriceDifference: int32(r.PriceDifference),
+	}
+}
+
+func newCreateGroupReservationResponse(r *bikerental.GroupReservationResponse) *bikerentalv1.CreateGroupReservationResponse {
+	if r == nil {
+		return nil
+	}
+
+	respReservations := make([]*bikerentalv1.Reservation, 0, len(r.Reservations))
+	for i := range r.Reservations {
+		respReservations = append(respReservations, newResponseReservation(&r.Reservations[i]))
+	}
+
+	return &bikerentalv1.CreateGroupReservationResponse{
+		Status:             newResponseReservationStatus(r.Status),
+		Reason:             r.Reason,
+		GroupId:            r.GroupID,
+		Reservations:       respReservations,
+		Discount:           newResponseDiscount(&r.Discount),
+		UnavailableBikeIds: r.UnavailableBikeIDs,
+	}
+}
+
+func newCreateRecurringReservationResponse(r *bikerental.RecurringReservationResponse) *bikerentalv1.CreateRecurringReservationResponse {
+	if r == nil {
+		return nil
+	}
+
+	respReservations := make([]*bikerentalv1.Reservation, 0, len(r.Reservations))
+	for i := range r.Reservations {
+		respReservations = append(respReservations, newResponseReservation(&r.Reservations[i]))
+	}
+	conflicts := make([]*bikerentalv1.Occurrence, 0, len(r.Conflicts))
+	for _, c := range r.Conflicts {
+		conflicts = append(conflicts, &bikerentalv1.Occurrence{
+			StartTime: timestamppb.New(c.StartTime),
+			EndTime:   timestamppb.New(c.EndTime),
+		})
+	}
+
+	return &bikerentalv1.CreateRecurringReservationResponse{
+		Status:       newResponseReservationStatus(r.Status),
+		Reason:       r.Reason,
+		SeriesId:     r.SeriesID,
+		Reservations: respReservations,
+		Conflicts:    conflicts,
+	}
+}
+
+func newListReservationsResponse(r *bikerental.ListReservationsResponse) *bikerentalv1.ListReservationsResponse {
+	if r == nil {
+		return nil
+	}
+
+	respReservations := make([]*bikerentalv1.Reservation, 0, len(r.Reservations))
+	for i := range r.Reservations {
+		respReservations = append(respReservations, newResponseReservation(&r.Reservations[i]))
+	}
+
+	return &bikerentalv1.ListReservationsResponse{
+		Reservations:  respReservations,
+		NextPageToken: r.NextPageToken,
+	}
+}
+
 func newResponseReservation(r *bikerental.Reservation) *bikerentalv1.Reservation {
 	if r == nil {
 		return nil
@@ -56,6 +195,208 @@ func newResponseReservation(r *bikerental.Reservation) *bikerentalv1.Reservation
 		EndTime:         timestamppb.New(r.EndTime),
 		TotalValue:      int32(r.TotalValue),
 		AppliedDiscount: int32(r.AppliedDiscount),
+		DiscountRule:    r.DiscountRule,
+		PromoCode:       r.PromoCode,
+		PickedUpAt:      newResponseOptionalTimestamp(r.PickedUpAt),
+		ReturnedAt:      newResponseOptionalTimestamp(r.ReturnedAt),
+		SeriesId:        r.SeriesID,
+		GroupId:         r.GroupID,
+		HoldExpiresAt:   newResponseOptionalTimestamp(r.HoldExpiresAt),
+		Refund:          int32(r.Refund),
+		CancellationFee: int32(r.CancellationFee),
+		CanceledAt:      newResponseOptionalTimestamp(r.CanceledAt),
+	}
+}
+
+func newCancelReservationResponse(c *bikerental.Cancellation) *bikerentalv1.CancelReservationResponse {
+	if c == nil {
+		return nil
+	}
+	return &bikerentalv1.CancelReservationResponse{
+		ReservationId: c.ReservationID,
+		Refund:        int32(c.Refund),
+		Fee:           int32(c.Fee),
+		CanceledAt:    timestamppb.New(c.CanceledAt),
+	}
+}
+
+func newResponseInvoice(i *bikerental.Invoice) *bikerentalv1.Invoice {
+	if i == nil {
+		return nil
+	}
+	return &bikerentalv1.Invoice{
+		ReservationId:    i.ReservationID,
+		ReservationValue: int32(i.ReservationValue),
+		Discount:         int32(i.Discount),
+		LateFee:          int32(i.LateFee),
+		LateHours:        int32(i.LateHours),
+		TotalValue:       int32(i.TotalValue),
+		CreatedAt:        timestamppb.New(i.CreatedAt),
+	}
+}
+
+// newResponseOptionalTimestamp returns nil for zero time.
+func newResponseOptionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func newListWaitlistResponse(entries []bikerental.WaitlistEntry) *bikerentalv1.ListWaitlistResponse {
+	respEntries := make([]*bikerentalv1.WaitlistEntry, 0, len(entries))
+	for i := range entries {
+		respEntries = append(respEntries, newResponseWaitlistEntry(&entries[i]))
+	}
+	return &bikerentalv1.ListWaitlistResponse{
+		Entries: respEntries,
+	}
+}
+
+func newResponseWaitlistEntry(e *bikerental.WaitlistEntry) *bikerentalv1.WaitlistEntry {
+	if e == nil {
+		return nil
+	}
+	return &bikerentalv1.WaitlistEntry{
+		Id:       e.ID,
+		Status:   newResponseWaitlistStatus(e.Status),
+		Customer: newResponseCustomer(&e.Customer),
+		BikeId:   e.BikeID,
+		Location: &bikerentalv1.Location{
+			Lat:  float32(e.Location.Lat),
+			Long: float32(e.Location.Long),
+		},
+		StartTime:       timestamppb.New(e.StartTime),
+		EndTime:         timestamppb.New(e.EndTime),
+		TotalValue:      int32(e.TotalValue),
+		AppliedDiscount: int32(e.AppliedDiscount),
+		DiscountRule:    e.DiscountRule,
+		ReservationId:   e.ReservationID,
+		CreatedAt:       timestamppb.New(e.CreatedAt),
+	}
+}
+
+func newResponseWaitlistStatus(s bikerental.WaitlistStatus) bikerentalv1.WaitlistStatus {
+	switch s {
+	case bikerental.WaitlistStatusWaiting:
+		return bikerentalv1.WaitlistStatus_WAITLIST_STATUS_WAITING
+	case bikerental.WaitlistStatusPromoted:
+		return bikerentalv1.WaitlistStatus_WAITLIST_STATUS_PROMOTED
+	case bikerental.WaitlistStatusWithdrawn:
+		return bikerentalv1.WaitlistStatus_WAITLIST_STATUS_WITHDRAWN
+	}
+	return bikerentalv1.WaitlistStatus_WAITLIST_STATUS_UNKNOWN
+}
+
+func newListMaintenanceResponse(ws []bikerental.MaintenanceWindow) *bikerentalv1.ListMaintenanceResponse {
+	respWindows := make([]*bikerentalv1.MaintenanceWindow, 0, len(ws))
+	for i := range ws {
+		respWindows = append(respWindows, newResponseMaintenanceWindow(&ws[i]))
+	}
+	return &bikerentalv1.ListMaintenanceResponse{
+		Windows: respWindows,
+	}
+}
+
+func newScheduleMaintenanceResponse(r *bikerental.ScheduleMaintenanceResponse) *bikerentalv1.ScheduleMaintenanceResponse {
+	if r == nil {
+		return nil
+	}
+
+	conflicts := make([]*bikerentalv1.Reservation, 0, len(r.Conflicts))
+	for i := range r.Conflicts {
+		conflicts = append(conflicts, newResponseReservation(&r.Conflicts[i]))
+	}
+
+	return &bikerentalv1.ScheduleMaintenanceResponse{
+		Window:    newResponseMaintenanceWindow(r.Window),
+		Conflicts: conflicts,
+	}
+}
+
+func newResponseMaintenanceWindow(w *bikerental.MaintenanceWindow) *bikerentalv1.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+	return &bikerentalv1.MaintenanceWindow{
+		Id:        w.ID,
+		BikeId:    w.BikeID,
+		StartTime: timestamppb.New(w.StartTime),
+		EndTime:   timestamppb.New(w.EndTime),
+		Reason:    w.Reason,
+	}
+}
+
+func newCheckDiscountResponse(r *bikerental.CheckDiscountResponse) *bikerentalv1.CheckDiscountResponse {
+	if r == nil {
+		return nil
+	}
+
+	candidates := make([]*bikerentalv1.Discount, 0, len(r.Candidates))
+	for i := range r.Candidates {
+		candidates = append(candidates, newResponseDiscount(&r.Candidates[i]))
+	}
+
+	return &bikerentalv1.CheckDiscountResponse{
+		ReservationValue: int32(r.ReservationValue),
+		Discount:         newResponseDiscount(&r.Discount),
+		Candidates:       candidates,
+	}
+}
+
+func newResponseDiscount(d *bikerental.Discount) *bikerentalv1.Discount {
+	if d == nil {
+		return nil
+	}
+	return &bikerentalv1.Discount{
+		Amount: int32(d.Amount),
+		Rule:   d.Rule,
+		Reason: d.Reason,
+	}
+}
+
+func newListPromoCodesResponse(pcs []bikerental.PromoCode) *bikerentalv1.ListPromoCodesResponse {
+	respCodes := make([]*bikerentalv1.PromoCode, 0, len(pcs))
+	for i := range pcs {
+		respCodes = append(respCodes, newResponsePromoCode(&pcs[i]))
+	}
+
+	return &bikerentalv1.ListPromoCodesResponse{
+		PromoCodes: respCodes,
+	}
+}
+
+func newResponsePromoCode(pc *bikerental.PromoCode) *bikerentalv1.PromoCode {
+	if pc == nil {
+		return nil
+	}
+	return &bikerentalv1.PromoCode{
+		Code:                      pc.Code,
+		Percent:                   pc.Percent,
+		Amount:                    int32(pc.Amount),
+		ValidFrom:                 timestamppb.New(pc.ValidFrom),
+		ValidTo:                   timestamppb.New(pc.ValidTo),
+		MaxRedemptions:            int32(pc.MaxRedemptions),
+		MaxRedemptionsPerCustomer: int32(pc.MaxRedemptionsPerCustomer),
+		Stackable:                 pc.Stackable,
+		Disabled:                  pc.Disabled,
+		Redemptions:               int32(pc.Redemptions),
+	}
+}
+
+func newListCustomersResponse(r *bikerental.ListCustomersResponse) *bikerentalv1.ListCustomersResponse {
+	if r == nil {
+		return nil
+	}
+
+	respCustomers := make([]*bikerentalv1.Customer, 0, len(r.Customers))
+	for i := range r.Customers {
+		respCustomers = append(respCustomers, newResponseCustomer(&r.Customers[i]))
+	}
+
+	return &bikerentalv1.ListCustomersResponse{
+		Customers:     respCustomers,
+		NextPageToken: r.NextPageToken,
 	}
 }
 
@@ -92,6 +433,16 @@ func newResponseReservationStatus(s bikerental.ReservationStatus) bikerentalv1.R
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_REJECTED
 	case bikerental.ReservationStatusCanceled:
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_CANCELLED
+	case bikerental.ReservationStatusActive:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_ACTIVE
+	case bikerental.ReservationStatusCompleted:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_COMPLETED
+	case bikerental.ReservationStatusNoShow:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_NO_SHOW
+	case bikerental.ReservationStatusPending:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_PENDING
+	case bikerental.ReservationStatusExpired:
+		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_EXPIRED
 	default:
 		status = bikerentalv1.ReservationStatus_RESERVATION_STATUS_UNKNOWN
 	}
@@ -21,41 +21,68 @@ import (
 // Server implements rpc ServiceServer.
 type Server struct {
 	bikeService        bikerental.BikeService
+	stationService     bikerental.StationService
 	reservationService bikerental.ReservationService
+	maintenanceService bikerental.MaintenanceService
+	customerService    bikerental.CustomerService
+	promoCodeService   bikerental.PromoCodeService
 	log                logrus.FieldLogger
 }
 
 // NewServer creates new Server instance.
 func NewServer(
 	bikeService bikerental.BikeService,
+	stationService bikerental.StationService,
 	reservationService bikerental.ReservationService,
+	maintenanceService bikerental.MaintenanceService,
+	customerService bikerental.CustomerService,
+	promoCodeService bikerental.PromoCodeService,
 	log logrus.FieldLogger,
 ) (*Server, error) {
 	if bikeService == nil {
 		return nil, errors.New("bike service is nil")
 	}
+	if stationService == nil {
+		return nil, errors.New("station service is nil")
+	}
 	if reservationService == nil {
 		return nil, errors.New("reservation service is nil")
 	}
+	if maintenanceService == nil {
+		return nil, errors.New("maintenance service is nil")
+	}
+	if customerService == nil {
+		return nil, errors.New("customer service is nil")
+	}
+	if promoCodeService == nil {
+		return nil, errors.New("promo code service is nil")
+	}
 	if log == nil {
 		return nil, errors.New("logger is nil")
 	}
 
 	return &Server{
 		bikeService:        bikeService,
+		stationService:     stationService,
 		reservationService: reservationService,
+		maintenanceService: maintenanceService,
+		customerService:    customerService,
+		promoCodeService:   promoCodeService,
 		log:                log,
 	}, nil
 }
 
-// ListBikes returns list of all bikes.
-func (s *Server) ListBikes(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListBikesResponse, error) {
-	bikes, err := s.bikeService.List(ctx)
+// ListBikes returns a page of bikes.
+func (s *Server) ListBikes(ctx context.Context, req *bikerentalv1.ListBikesRequest) (*bikerentalv1.ListBikesResponse, error) {
+	resp, err := s.bikeService.List(ctx, bikerental.ListBikesRequest{
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
 	if err != nil {
 		s.logError(ctx, err, "ListBikes")
 		return nil, NewServerError(err)
 	}
-	return newListBikesResponse(bikes), nil
+	return newListBikesResponse(resp), nil
 }
 
 // GetBike returns a bike.
@@ -120,6 +147,7 @@ func (s *Server) GetBikeAvailability(ctx context.Context, req *bikerentalv1.GetB
 		req.BikeId,
 		req.StartTime.AsTime(),
 		req.EndTime.AsTime(),
+		newAppLocationFromRequest(req.Location),
 	)
 	if err != nil {
 		s.logError(ctx, err, "GetBikeAvailability")
@@ -131,25 +159,40 @@ func (s *Server) GetBikeAvailability(ctx context.Context, req *bikerentalv1.GetB
 	}, nil
 }
 
-// ListReservations returns list of reservations for a bike.
+// SearchAvailableBikes returns bikes available for rent in given time range.
+func (s *Server) SearchAvailableBikes(ctx context.Context, req *bikerentalv1.SearchAvailableBikesRequest) (*bikerentalv1.SearchAvailableBikesResponse, error) {
+	bikes, err := s.reservationService.SearchAvailableBikes(ctx, bikerental.SearchAvailableBikesRequest{
+		StartTime:       req.StartTime.AsTime(),
+		EndTime:         req.EndTime.AsTime(),
+		Location:        newAppLocationFromRequest(req.Location),
+		Radius:          req.Radius,
+		MinWeight:       float64(req.MinWeight),
+		MaxWeight:       float64(req.MaxWeight),
+		MaxPricePerHour: int(req.MaxPricePerHour),
+		Limit:           int(req.PageSize),
+	})
+	if err != nil {
+		s.logError(ctx, err, "SearchAvailableBikes")
+		return nil, NewServerError(err)
+	}
+	return newSearchAvailableBikesResponse(bikes), nil
+}
+
+// ListReservations returns a page of reservations for a bike.
 func (s *Server) ListReservations(ctx context.Context, req *bikerentalv1.ListReservationsRequest) (*bikerentalv1.ListReservationsResponse, error) {
-	reservations, err := s.reservationService.ListReservations(ctx, bikerental.ListReservationsRequest{
+	resp, err := s.reservationService.ListReservations(ctx, bikerental.ListReservationsRequest{
 		BikeID:    req.BikeId,
 		StartTime: req.StartTime.AsTime(),
 		EndTime:   req.EndTime.AsTime(),
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
 	})
 	if err != nil {
 		s.logError(ctx, err, "ListReservations")
 		return nil, NewServerError(err)
 	}
 
-	outrs := make([]*bikerentalv1.Reservation, 0, len(reservations))
-	for i := range reservations {
-		outrs = append(outrs, newResponseReservation(&reservations[i]))
-	}
-	return &bikerentalv1.ListReservationsResponse{
-		Reservations: outrs,
-	}, nil
+	return newListReservationsResponse(resp), nil
 }
 
 // CreateReservation creates new reservation.
@@ -171,6 +214,7 @@ func (s *Server) CreateReservation(ctx context.Context, req *bikerentalv1.Create
 		Location:  *location,
 		StartTime: req.StartTime.AsTime(),
 		EndTime:   req.EndTime.AsTime(),
+		PromoCode: req.PromoCode,
 	})
 	if err != nil {
 		s.logError(ctx, err, "CreateReservation")
@@ -186,13 +230,535 @@ func (s *Server) CreateReservation(ctx context.Context, req *bikerentalv1.Create
 	return newCreateReservationResponse(resp), nil
 }
 
+// UpdateReservation changes time range or bike of existing reservation.
+func (s *Server) UpdateReservation(ctx context.Context, req *bikerentalv1.UpdateReservationRequest) (*bikerentalv1.UpdateReservationResponse, error) {
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	resp, err := s.reservationService.UpdateReservation(ctx, bikerental.UpdateReservationRequest{
+		ID:        req.Id,
+		BikeID:    req.BikeId,
+		NewBikeID: req.NewBikeId,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+	})
+	if err != nil {
+		s.logError(ctx, err, "UpdateReservation")
+		return nil, NewServerError(err)
+	}
+
+	if resp.Reservation != nil {
+		s.logInfo(ctx, "UpdateReservation", "reservation updated: %s, price difference: %d", resp.Reservation.ID, resp.PriceDifference)
+		s.logPromoted(ctx, "UpdateReservation", resp.Promoted)
+	} else {
+		s.logInfo(ctx, "UpdateReservation", "reservation not updated, reason: %s", resp.Reason)
+	}
+
+	return newUpdateReservationResponse(resp), nil
+}
+
+// HoldBike creates pending reservation, which blocks the bike until it's confirmed or its hold expires.
+func (s *Server) HoldBike(ctx context.Context, req *bikerentalv1.CreateReservationRequest) (*bikerentalv1.CreateReservationResponse, error) {
+	if req.Customer == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
+	}
+	customer := newAppCustomerFromRequest(req.Customer)
+
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	resp, err := s.reservationService.HoldBike(ctx, bikerental.CreateReservationRequest{
+		BikeID:    req.BikeId,
+		Customer:  *customer,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		PromoCode: req.PromoCode,
+	})
+	if err != nil {
+		s.logError(ctx, err, "HoldBike")
+		return nil, NewServerError(err)
+	}
+
+	if resp.Reservation != nil {
+		s.logInfo(ctx, "HoldBike", "bike held, reservation: %s", resp.Reservation.ID)
+	} else {
+		s.logInfo(ctx, "HoldBike", "bike not held, reason: %s", resp.Reason)
+	}
+
+	return newCreateReservationResponse(resp), nil
+}
+
+// ConfirmReservation approves pending reservation.
+func (s *Server) ConfirmReservation(ctx context.Context, req *bikerentalv1.ConfirmReservationRequest) (*bikerentalv1.Reservation, error) {
+	r, err := s.reservationService.ConfirmReservation(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "ConfirmReservation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "ConfirmReservation", "reservation confirmed: %s", r.ID)
+
+	return newResponseReservation(r), nil
+}
+
+// CreateGroupReservation reserves multiple bikes together.
+func (s *Server) CreateGroupReservation(ctx context.Context, req *bikerentalv1.CreateGroupReservationRequest) (*bikerentalv1.CreateGroupReservationResponse, error) {
+	if req.Customer == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
+	}
+	customer := newAppCustomerFromRequest(req.Customer)
+
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	resp, err := s.reservationService.CreateGroupReservation(ctx, bikerental.CreateGroupReservationRequest{
+		BikeIDs:   req.BikeIds,
+		Customer:  *customer,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+	})
+	if err != nil {
+		s.logError(ctx, err, "CreateGroupReservation")
+		return nil, NewServerError(err)
+	}
+
+	if resp.GroupID != "" {
+		s.logInfo(ctx, "CreateGroupReservation", "group reservation created: %s", resp.GroupID)
+	} else {
+		s.logInfo(ctx, "CreateGroupReservation", "group reservation not created, reason: %s", resp.Reason)
+	}
+
+	return newCreateGroupReservationResponse(resp), nil
+}
+
+// CreateRecurringReservation creates a series of reservations.
+func (s *Server) CreateRecurringReservation(ctx context.Context, req *bikerentalv1.CreateRecurringReservationRequest) (*bikerentalv1.CreateRecurringReservationResponse, error) {
+	if req.Customer == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
+	}
+	customer := newAppCustomerFromRequest(req.Customer)
+
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	resp, err := s.reservationService.CreateRecurringReservation(ctx, bikerental.CreateRecurringReservationRequest{
+		BikeID:    req.BikeId,
+		Customer:  *customer,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		Rule:      req.Rule,
+		Until:     req.Until.AsTime(),
+		TimeZone:  req.TimeZone,
+	})
+	if err != nil {
+		s.logError(ctx, err, "CreateRecurringReservation")
+		return nil, NewServerError(err)
+	}
+
+	if resp.SeriesID != "" {
+		s.logInfo(ctx, "CreateRecurringReservation", "reservation series created: %s", resp.SeriesID)
+	} else {
+		s.logInfo(ctx, "CreateRecurringReservation", "reservation series not created, reason: %s", resp.Reason)
+	}
+
+	return newCreateRecurringReservationResponse(resp), nil
+}
+
+// CancelReservationSeries cancels all approved reservations from a series.
+func (s *Server) CancelReservationSeries(ctx context.Context, req *bikerentalv1.CancelReservationSeriesRequest) (*bikerentalv1.CancelReservationSeriesResponse, error) {
+	resp, err := s.reservationService.CancelReservationSeries(ctx, req.BikeId, req.SeriesId)
+	if err != nil {
+		s.logError(ctx, err, "CancelReservationSeries")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CancelReservationSeries", "reservation series %s canceled, %d reservations", req.SeriesId, len(resp.Cancellations))
+	s.logPromoted(ctx, "CancelReservationSeries", resp.Promoted)
+
+	return &bikerentalv1.CancelReservationSeriesResponse{
+		Canceled: int32(len(resp.Cancellations)),
+	}, nil
+}
+
 // CancelReservation cancels reservation for a bike.
-func (s *Server) CancelReservation(ctx context.Context, req *bikerentalv1.CancelReservationRequest) (*empty.Empty, error) {
-	if err := s.reservationService.CancelReservation(ctx, req.BikeId, req.Id); err != nil {
+func (s *Server) CancelReservation(ctx context.Context, req *bikerentalv1.CancelReservationRequest) (*bikerentalv1.CancelReservationResponse, error) {
+	resp, err := s.reservationService.CancelReservation(ctx, req.BikeId, req.Id)
+	if err != nil {
 		s.logError(ctx, err, "CancelReservation")
 		return nil, NewServerError(err)
 	}
 
+	c := resp.Cancellation
+	s.logInfo(ctx, "CancelReservation", "reservation canceled: %s, refund: %d, fee: %d", c.ReservationID, c.Refund, c.Fee)
+	s.logPromoted(ctx, "CancelReservation", resp.Promoted)
+
+	return newCancelReservationResponse(&c), nil
+}
+
+// StartRental marks reservation as active.
+func (s *Server) StartRental(ctx context.Context, req *bikerentalv1.StartRentalRequest) (*bikerentalv1.Reservation, error) {
+	r, err := s.reservationService.StartRental(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "StartRental")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "StartRental", "rental started: %s", r.ID)
+
+	return newResponseReservation(r), nil
+}
+
+// CompleteRental marks reservation as completed.
+func (s *Server) CompleteRental(ctx context.Context, req *bikerentalv1.CompleteRentalRequest) (*bikerentalv1.Reservation, error) {
+	r, err := s.reservationService.CompleteRental(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "CompleteRental")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CompleteRental", "rental completed: %s", r.ID)
+
+	return newResponseReservation(r), nil
+}
+
+// MarkNoShow marks reservation as not picked up.
+func (s *Server) MarkNoShow(ctx context.Context, req *bikerentalv1.MarkNoShowRequest) (*bikerentalv1.Reservation, error) {
+	resp, err := s.reservationService.MarkNoShow(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "MarkNoShow")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "MarkNoShow", "reservation marked as no-show: %s", resp.Reservation.ID)
+	s.logPromoted(ctx, "MarkNoShow", resp.Promoted)
+
+	return newResponseReservation(resp.Reservation), nil
+}
+
+// GetInvoice returns invoice of a completed rental.
+func (s *Server) GetInvoice(ctx context.Context, req *bikerentalv1.GetInvoiceRequest) (*bikerentalv1.Invoice, error) {
+	i, err := s.reservationService.GetInvoice(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "GetInvoice")
+		return nil, NewServerError(err)
+	}
+	return newResponseInvoice(i), nil
+}
+
+// CheckDiscount returns possible discount for a reservation.
+func (s *Server) CheckDiscount(ctx context.Context, req *bikerentalv1.CheckDiscountRequest) (*bikerentalv1.CheckDiscountResponse, error) {
+	if req.Customer == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
+	}
+	customer := newAppCustomerFromRequest(req.Customer)
+
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	resp, err := s.reservationService.CheckDiscount(ctx, bikerental.CheckDiscountRequest{
+		BikeID:    req.BikeId,
+		Customer:  *customer,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+	})
+	if err != nil {
+		s.logError(ctx, err, "CheckDiscount")
+		return nil, NewServerError(err)
+	}
+
+	return newCheckDiscountResponse(resp), nil
+}
+
+// ListStations returns list of all stations.
+func (s *Server) ListStations(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListStationsResponse, error) {
+	stations, err := s.stationService.List(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListStations")
+		return nil, NewServerError(err)
+	}
+	return newListStationsResponse(stations), nil
+}
+
+// GetStation returns a station.
+func (s *Server) GetStation(ctx context.Context, req *bikerentalv1.GetStationRequest) (*bikerentalv1.Station, error) {
+	st, err := s.stationService.Get(ctx, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "GetStation")
+		return nil, NewServerError(err)
+	}
+	return newResponseStation(st), nil
+}
+
+// CreateStation creates new station.
+func (s *Server) CreateStation(ctx context.Context, req *bikerentalv1.CreateStationRequest) (*bikerentalv1.Station, error) {
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "station data can't be empty")
+	}
+	st := newAppStationFromRequestData(req.Data)
+	createdStation, err := s.stationService.Add(ctx, *st)
+	if err != nil {
+		s.logError(ctx, err, "CreateStation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreateStation", "station created: %s", createdStation.ID)
+
+	return newResponseStation(createdStation), nil
+}
+
+// UpdateStation updates a station.
+func (s *Server) UpdateStation(ctx context.Context, req *bikerentalv1.UpdateStationRequest) (*empty.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "station id can't be empty")
+	}
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "station data can't be empty")
+	}
+	st := newAppStationFromRequestData(req.Data)
+	if err := s.stationService.Update(ctx, req.Id, *st); err != nil {
+		s.logError(ctx, err, "UpdateStation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "UpdateStation", "station updated: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteStation deletes a station.
+func (s *Server) DeleteStation(ctx context.Context, req *bikerentalv1.DeleteStationRequest) (*empty.Empty, error) {
+	if err := s.stationService.Delete(ctx, req.Id); err != nil {
+		s.logError(ctx, err, "DeleteStation")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "DeleteStation", "station delete ok: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// JoinWaitlist enqueues reservation request on the bike waitlist.
+func (s *Server) JoinWaitlist(ctx context.Context, req *bikerentalv1.CreateReservationRequest) (*bikerentalv1.WaitlistEntry, error) {
+	if req.Customer == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer can't be empty")
+	}
+	customer := newAppCustomerFromRequest(req.Customer)
+
+	if req.Location == nil {
+		return nil, status.Error(codes.InvalidArgument, "location can't be empty")
+	}
+	location := newAppLocationFromRequest(req.Location)
+
+	entry, err := s.reservationService.JoinWaitlist(ctx, bikerental.CreateReservationRequest{
+		BikeID:    req.BikeId,
+		Customer:  *customer,
+		Location:  *location,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		PromoCode: req.PromoCode,
+	})
+	if err != nil {
+		s.logError(ctx, err, "JoinWaitlist")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "JoinWaitlist", "waitlist entry created: %s", entry.ID)
+
+	return newResponseWaitlistEntry(entry), nil
+}
+
+// ListWaitlist returns waitlist entries for a bike.
+func (s *Server) ListWaitlist(ctx context.Context, req *bikerentalv1.ListWaitlistRequest) (*bikerentalv1.ListWaitlistResponse, error) {
+	entries, err := s.reservationService.ListWaitlist(ctx, req.BikeId)
+	if err != nil {
+		s.logError(ctx, err, "ListWaitlist")
+		return nil, NewServerError(err)
+	}
+	return newListWaitlistResponse(entries), nil
+}
+
+// WithdrawWaitlistEntry removes entry from the waitlist.
+func (s *Server) WithdrawWaitlistEntry(ctx context.Context, req *bikerentalv1.WithdrawWaitlistEntryRequest) (*empty.Empty, error) {
+	if err := s.reservationService.WithdrawWaitlistEntry(ctx, req.BikeId, req.Id); err != nil {
+		s.logError(ctx, err, "WithdrawWaitlistEntry")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "WithdrawWaitlistEntry", "waitlist entry withdraw ok: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListMaintenance returns maintenance windows of a bike.
+func (s *Server) ListMaintenance(ctx context.Context, req *bikerentalv1.ListMaintenanceRequest) (*bikerentalv1.ListMaintenanceResponse, error) {
+	ws, err := s.maintenanceService.List(ctx, req.BikeId, newAppOptionalTime(req.StartTime), newAppOptionalTime(req.EndTime))
+	if err != nil {
+		s.logError(ctx, err, "ListMaintenance")
+		return nil, NewServerError(err)
+	}
+	return newListMaintenanceResponse(ws), nil
+}
+
+// ScheduleMaintenance schedules new maintenance window for a bike.
+func (s *Server) ScheduleMaintenance(ctx context.Context, req *bikerentalv1.ScheduleMaintenanceRequest) (*bikerentalv1.ScheduleMaintenanceResponse, error) {
+	resp, err := s.maintenanceService.Schedule(ctx, bikerental.MaintenanceWindow{
+		BikeID:    req.BikeId,
+		StartTime: req.StartTime.AsTime(),
+		EndTime:   req.EndTime.AsTime(),
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		s.logError(ctx, err, "ScheduleMaintenance")
+		return nil, NewServerError(err)
+	}
+
+	if resp.Window != nil {
+		s.logInfo(ctx, "ScheduleMaintenance", "maintenance scheduled: %s", resp.Window.ID)
+	} else {
+		s.logInfo(ctx, "ScheduleMaintenance", "maintenance conflicts with %d reservations", len(resp.Conflicts))
+	}
+
+	return newScheduleMaintenanceResponse(resp), nil
+}
+
+// CancelMaintenance cancels scheduled maintenance window.
+func (s *Server) CancelMaintenance(ctx context.Context, req *bikerentalv1.CancelMaintenanceRequest) (*empty.Empty, error) {
+	promoted, err := s.maintenanceService.Cancel(ctx, req.BikeId, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "CancelMaintenance")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CancelMaintenance", "maintenance cancel ok: %s", req.Id)
+	s.logPromoted(ctx, "CancelMaintenance", promoted)
+
+	return &empty.Empty{}, nil
+}
+
+// ListCustomers returns a page of customers.
+func (s *Server) ListCustomers(ctx context.Context, req *bikerentalv1.ListCustomersRequest) (*bikerentalv1.ListCustomersResponse, error) {
+	resp, err := s.customerService.List(ctx, bikerental.ListCustomersRequest{
+		Email:     req.Email,
+		Type:      newAppCustomerType(req.Type),
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		s.logError(ctx, err, "ListCustomers")
+		return nil, NewServerError(err)
+	}
+	return newListCustomersResponse(resp), nil
+}
+
+// GetCustomer returns a customer.
+func (s *Server) GetCustomer(ctx context.Context, req *bikerentalv1.GetCustomerRequest) (*bikerentalv1.Customer, error) {
+	c, err := s.customerService.Get(ctx, req.Id)
+	if err != nil {
+		s.logError(ctx, err, "GetCustomer")
+		return nil, NewServerError(err)
+	}
+	return newResponseCustomer(c), nil
+}
+
+// CreateCustomer creates new customer.
+func (s *Server) CreateCustomer(ctx context.Context, req *bikerentalv1.CreateCustomerRequest) (*bikerentalv1.Customer, error) {
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer data can't be empty")
+	}
+	c := newAppCustomerFromRequestData(req.Data)
+	createdCustomer, err := s.customerService.Add(ctx, *c)
+	if err != nil {
+		s.logError(ctx, err, "CreateCustomer")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreateCustomer", "customer created: %s", createdCustomer.ID)
+
+	return newResponseCustomer(createdCustomer), nil
+}
+
+// UpdateCustomer updates a customer.
+func (s *Server) UpdateCustomer(ctx context.Context, req *bikerentalv1.UpdateCustomerRequest) (*empty.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "customer id can't be empty")
+	}
+	if req.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "customer data can't be empty")
+	}
+	c := newAppCustomerFromRequestData(req.Data)
+	if err := s.customerService.Update(ctx, req.Id, *c); err != nil {
+		s.logError(ctx, err, "UpdateCustomer")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "UpdateCustomer", "customer updated: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteCustomer deletes a customer.
+func (s *Server) DeleteCustomer(ctx context.Context, req *bikerentalv1.DeleteCustomerRequest) (*empty.Empty, error) {
+	if err := s.customerService.Delete(ctx, req.Id); err != nil {
+		s.logError(ctx, err, "DeleteCustomer")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "DeleteCustomer", "customer delete ok: %s", req.Id)
+
+	return &empty.Empty{}, nil
+}
+
+// ListPromoCodes returns list of all promo codes.
+func (s *Server) ListPromoCodes(ctx context.Context, _ *empty.Empty) (*bikerentalv1.ListPromoCodesResponse, error) {
+	pcs, err := s.promoCodeService.List(ctx)
+	if err != nil {
+		s.logError(ctx, err, "ListPromoCodes")
+		return nil, NewServerError(err)
+	}
+	return newListPromoCodesResponse(pcs), nil
+}
+
+// CreatePromoCode creates new promo code.
+func (s *Server) CreatePromoCode(ctx context.Context, req *bikerentalv1.CreatePromoCodeRequest) (*bikerentalv1.PromoCode, error) {
+	if req.PromoCode == nil {
+		return nil, status.Error(codes.InvalidArgument, "promo code can't be empty")
+	}
+	pc := newAppPromoCodeFromRequest(req.PromoCode)
+	created, err := s.promoCodeService.Create(ctx, *pc)
+	if err != nil {
+		s.logError(ctx, err, "CreatePromoCode")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "CreatePromoCode", "promo code created: %s", created.Code)
+
+	return newResponsePromoCode(created), nil
+}
+
+// DisablePromoCode disables promo code.
+func (s *Server) DisablePromoCode(ctx context.Context, req *bikerentalv1.DisablePromoCodeRequest) (*empty.Empty, error) {
+	if err := s.promoCodeService.Disable(ctx, req.Code); err != nil {
+		s.logError(ctx, err, "DisablePromoCode")
+		return nil, NewServerError(err)
+	}
+
+	s.logInfo(ctx, "DisablePromoCode", "promo code disabled: %s", req.Code)
+
 	return &empty.Empty{}, nil
 }
 
@@ -216,6 +782,13 @@ func (s *Server) logInfo(ctx context.Context, endpoint string, format string, ar
 	)
 }
 
+// logPromoted logs reservations promoted from waitlist while handling request.
+func (s *Server) logPromoted(ctx context.Context, endpoint string, promoted []bikerental.Reservation) {
+	for _, r := range promoted {
+		s.logInfo(ctx, endpoint, "waitlist entry promoted to reservation: %s", r.ID)
+	}
+}
+
 // RunServer starts grpc server with ServiceServer service.
 // Server is gracefully shut down on context cancellation.
 func RunServer(
@@ -225,10 +798,23 @@ func RunServer(
 	srv bikerentalv1.BikeRentalServiceServer,
 	lis net.Listener,
 ) error {
+	// Interceptors are called in the given order, so trace id and log context are available for all following ones.
+	// Panics unwind past metrics and access log interceptors, so recovery interceptor records such requests in both itself.
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(TraceIDUnaryServerInterceptor()),
-		grpc.UnaryInterceptor(LogCtxUnaryServerInterceptor()),
-		grpc.UnaryInterceptor(MetricsUnaryServerInterceptor(met)),
+		grpc.ChainUnaryInterceptor(
+			TraceIDUnaryServerInterceptor(log),
+			LogCtxUnaryServerInterceptor(),
+			RecoveryUnaryServerInterceptor(log, met),
+			MetricsUnaryServerInterceptor(met),
+			AccessLogUnaryServerInterceptor(log),
+		),
+		grpc.ChainStreamInterceptor(
+			TraceIDStreamServerInterceptor(log),
+			LogCtxStreamServerInterceptor(),
+			RecoveryStreamServerInterceptor(log, met),
+			MetricsStreamServerInterceptor(met),
+			AccessLogStreamServerInterceptor(log),
+		),
 	)
 	bikerentalv1.RegisterBikeRentalServiceServer(s, srv)
 	go func() {
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.6.1
 // source: nglogic/bikerental/v1/service.proto
 
@@ -15,7 +15,6 @@ import (
 	reflect "reflect"
 	sync "sync"
 
-	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -33,9 +32,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
+type BikeStatus int32
+
+const (
+	BikeStatus_BIKE_STATUS_UNKNOWN     BikeStatus = 0
+	BikeStatus_BIKE_STATUS_AVAILABLE   BikeStatus = 1
+	BikeStatus_BIKE_STATUS_MAINTENANCE BikeStatus = 2
+	BikeStatus_BIKE_STATUS_RETIRED     BikeStatus = 3
+)
+
+// Enum value maps for BikeStatus.
+var (
+	BikeStatus_name = map[int32]string{
+		0: "BIKE_STATUS_UNKNOWN",
+		1: "BIKE_STATUS_AVAILABLE",
+		2: "BIKE_STATUS_MAINTENANCE",
+		3: "BIKE_STATUS_RETIRED",
+	}
+	BikeStatus_value = map[string]int32{
+		"BIKE_STATUS_UNKNOWN":     0,
+		"BIKE_STATUS_AVAILABLE":   1,
+		"BIKE_STATUS_MAINTENANCE": 2,
+		"BIKE_STATUS_RETIRED":     3,
+	}
+)
+
+func (x BikeStatus) Enum() *BikeStatus {
+	p := new(BikeStatus)
+	*p = x
+	return p
+}
+
+func (x BikeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BikeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (BikeStatus) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[0]
+}
+
+func (x BikeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BikeStatus.Descriptor instead.
+func (BikeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
+}
 
 type CustomerType int32
 
@@ -70,11 +117,11 @@ func (x CustomerType) String() string {
 }
 
 func (CustomerType) Descriptor() protoreflect.EnumDescriptor {
-	return file_nglogic_bikerental_v1_service_proto_enumTypes[0].Descriptor()
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[1].Descriptor()
 }
 
 func (CustomerType) Type() protoreflect.EnumType {
-	return &file_nglogic_bikerental_v1_service_proto_enumTypes[0]
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[1]
 }
 
 func (x CustomerType) Number() protoreflect.EnumNumber {
@@ -83,7 +130,7 @@ func (x CustomerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CustomerType.Descriptor instead.
 func (CustomerType) EnumDescriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{0}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
 type ReservationStatus int32
@@ -93,6 +140,11 @@ const (
 	ReservationStatus_RESERVATION_STATUS_REJECTED  ReservationStatus = 1
 	ReservationStatus_RESERVATION_STATUS_APPROVED  ReservationStatus = 2
 	ReservationStatus_RESERVATION_STATUS_CANCELLED ReservationStatus = 3
+	ReservationStatus_RESERVATION_STATUS_ACTIVE    ReservationStatus = 4
+	ReservationStatus_RESERVATION_STATUS_COMPLETED ReservationStatus = 5
+	ReservationStatus_RESERVATION_STATUS_NO_SHOW   ReservationStatus = 6
+	ReservationStatus_RESERVATION_STATUS_PENDING   ReservationStatus = 7
+	ReservationStatus_RESERVATION_STATUS_EXPIRED   ReservationStatus = 8
 )
 
 // Enum value maps for ReservationStatus.
@@ -102,12 +154,22 @@ var (
 		1: "RESERVATION_STATUS_REJECTED",
 		2: "RESERVATION_STATUS_APPROVED",
 		3: "RESERVATION_STATUS_CANCELLED",
+		4: "RESERVATION_STATUS_ACTIVE",
+		5: "RESERVATION_STATUS_COMPLETED",
+		6: "RESERVATION_STATUS_NO_SHOW",
+		7: "RESERVATION_STATUS_PENDING",
+		8: "RESERVATION_STATUS_EXPIRED",
 	}
 	ReservationStatus_value = map[string]int32{
 		"RESERVATION_STATUS_UNKNOWN":   0,
 		"RESERVATION_STATUS_REJECTED":  1,
 		"RESERVATION_STATUS_APPROVED":  2,
 		"RESERVATION_STATUS_CANCELLED": 3,
+		"RESERVATION_STATUS_ACTIVE":    4,
+		"RESERVATION_STATUS_COMPLETED": 5,
+		"RESERVATION_STATUS_NO_SHOW":   6,
+		"RESERVATION_STATUS_PENDING":   7,
+		"RESERVATION_STATUS_EXPIRED":   8,
 	}
 )
 
@@ -122,11 +184,11 @@ func (x ReservationStatus) String() string {
 }
 
 func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_nglogic_bikerental_v1_service_proto_enumTypes[1].Descriptor()
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[2].Descriptor()
 }
 
 func (ReservationStatus) Type() protoreflect.EnumType {
-	return &file_nglogic_bikerental_v1_service_proto_enumTypes[1]
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[2]
 }
 
 func (x ReservationStatus) Number() protoreflect.EnumNumber {
@@ -135,7 +197,59 @@ func (x ReservationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReservationStatus.Descriptor instead.
 func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{1}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type WaitlistStatus int32
+
+const (
+	WaitlistStatus_WAITLIST_STATUS_UNKNOWN   WaitlistStatus = 0
+	WaitlistStatus_WAITLIST_STATUS_WAITING   WaitlistStatus = 1
+	WaitlistStatus_WAITLIST_STATUS_PROMOTED  WaitlistStatus = 2
+	WaitlistStatus_WAITLIST_STATUS_WITHDRAWN WaitlistStatus = 3
+)
+
+// Enum value maps for WaitlistStatus.
+var (
+	WaitlistStatus_name = map[int32]string{
+		0: "WAITLIST_STATUS_UNKNOWN",
+		1: "WAITLIST_STATUS_WAITING",
+		2: "WAITLIST_STATUS_PROMOTED",
+		3: "WAITLIST_STATUS_WITHDRAWN",
+	}
+	WaitlistStatus_value = map[string]int32{
+		"WAITLIST_STATUS_UNKNOWN":   0,
+		"WAITLIST_STATUS_WAITING":   1,
+		"WAITLIST_STATUS_PROMOTED":  2,
+		"WAITLIST_STATUS_WITHDRAWN": 3,
+	}
+)
+
+func (x WaitlistStatus) Enum() *WaitlistStatus {
+	p := new(WaitlistStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nglogic_bikerental_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (WaitlistStatus) Type() protoreflect.EnumType {
+	return &file_nglogic_bikerental_v1_service_proto_enumTypes[3]
+}
+
+func (x WaitlistStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistStatus.Descriptor instead.
+func (WaitlistStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
 }
 
 type Bike struct {
@@ -201,6 +315,12 @@ type BikeData struct {
 	ModelName    string  `protobuf:"bytes,1,opt,name=modelName,proto3" json:"modelName,omitempty"`
 	Weight       float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	PricePerHour int32   `protobuf:"varint,3,opt,name=pricePerHour,proto3" json:"pricePerHour,omitempty"`
+	// Identifies physical bike unit. Optional.
+	SerialNumber string `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Station where the bike is kept. Optional.
+	StationId string `protobuf:"bytes,5,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	// Defaults to available for new bikes. Not changed on update if not set.
+	Status BikeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=nglogic.bikerental.v1.BikeStatus" json:"status,omitempty"`
 }
 
 func (x *BikeData) Reset() {
@@ -256,6 +376,146 @@ func (x *BikeData) GetPricePerHour() int32 {
 	return 0
 }
 
+func (x *BikeData) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *BikeData) GetStationId() string {
+	if x != nil {
+		return x.StationId
+	}
+	return ""
+}
+
+func (x *BikeData) GetStatus() BikeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BikeStatus_BIKE_STATUS_UNKNOWN
+}
+
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *StationData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Station) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Station) GetData() *StationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StationData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Location *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Max number of bikes assigned to the station.
+	Capacity int32 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *StationData) Reset() {
+	*x = StationData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationData) ProtoMessage() {}
+
+func (x *StationData) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationData.ProtoReflect.Descriptor instead.
+func (*StationData) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StationData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StationData) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *StationData) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
 type Customer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -268,7 +528,7 @@ type Customer struct {
 func (x *Customer) Reset() {
 	*x = Customer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +541,7 @@ func (x *Customer) String() string {
 func (*Customer) ProtoMessage() {}
 
 func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[2]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +554,7 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Customer.ProtoReflect.Descriptor instead.
 func (*Customer) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{2}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Customer) GetId() string {
@@ -325,7 +585,7 @@ type CustomerData struct {
 func (x *CustomerData) Reset() {
 	*x = CustomerData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +598,7 @@ func (x *CustomerData) String() string {
 func (*CustomerData) ProtoMessage() {}
 
 func (x *CustomerData) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[3]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +611,7 @@ func (x *CustomerData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomerData.ProtoReflect.Descriptor instead.
 func (*CustomerData) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{3}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CustomerData) GetType() CustomerType {
@@ -395,12 +655,31 @@ type Reservation struct {
 	EndTime         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	TotalValue      int32                `protobuf:"varint,7,opt,name=totalValue,proto3" json:"totalValue,omitempty"`
 	AppliedDiscount int32                `protobuf:"varint,8,opt,name=appliedDiscount,proto3" json:"appliedDiscount,omitempty"`
+	// Identifier of the discount rule that produced applied discount.
+	DiscountRule string `protobuf:"bytes,9,opt,name=discountRule,proto3" json:"discountRule,omitempty"`
+	// Promo code redeemed with the reservation.
+	PromoCode string `protobuf:"bytes,10,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	// Actual time of picking up the bike.
+	PickedUpAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=picked_up_at,json=pickedUpAt,proto3" json:"picked_up_at,omitempty"`
+	// Actual time of returning the bike.
+	ReturnedAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=returned_at,json=returnedAt,proto3" json:"returned_at,omitempty"`
+	// Identifier of recurring reservation series. Empty for single reservations.
+	SeriesId string `protobuf:"bytes,13,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Identifier of group reservation. Empty for single reservations.
+	GroupId string `protobuf:"bytes,14,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Time until pending reservation holds the bike. Empty for reservations created without hold.
+	HoldExpiresAt *timestamp.Timestamp `protobuf:"bytes,15,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`
+	// Amounts settled when reservation was canceled.
+	Refund          int32 `protobuf:"varint,16,opt,name=refund,proto3" json:"refund,omitempty"`
+	CancellationFee int32 `protobuf:"varint,17,opt,name=cancellation_fee,json=cancellationFee,proto3" json:"cancellation_fee,omitempty"`
+	// Time of canceling the reservation.
+	CanceledAt *timestamp.Timestamp `protobuf:"bytes,18,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
 }
 
 func (x *Reservation) Reset() {
 	*x = Reservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +692,7 @@ func (x *Reservation) String() string {
 func (*Reservation) ProtoMessage() {}
 
 func (x *Reservation) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[4]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +705,7 @@ func (x *Reservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
 func (*Reservation) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{4}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Reservation) GetId() string {
@@ -485,6 +764,76 @@ func (x *Reservation) GetAppliedDiscount() int32 {
 	return 0
 }
 
+func (x *Reservation) GetDiscountRule() string {
+	if x != nil {
+		return x.DiscountRule
+	}
+	return ""
+}
+
+func (x *Reservation) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Reservation) GetPickedUpAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PickedUpAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetReturnedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReturnedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Reservation) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Reservation) GetHoldExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetRefund() int32 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *Reservation) GetCancellationFee() int32 {
+	if x != nil {
+		return x.CancellationFee
+	}
+	return 0
+}
+
+func (x *Reservation) GetCanceledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
 type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -497,7 +846,7 @@ type Location struct {
 func (x *Location) Reset() {
 	*x = Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -510,7 +859,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[5]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +872,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{5}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Location) GetLat() float32 {
@@ -540,31 +889,34 @@ func (x *Location) GetLong() float32 {
 	return 0
 }
 
-type ListBikesResponse struct {
+type ListBikesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bikes []*Bike `protobuf:"bytes,1,rep,name=bikes,proto3" json:"bikes,omitempty"`
+	// Maximum number of bikes to return, default is 10, maximum is 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from previous response, used to fetch the next page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *ListBikesResponse) Reset() {
-	*x = ListBikesResponse{}
+func (x *ListBikesRequest) Reset() {
+	*x = ListBikesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBikesResponse) String() string {
+func (x *ListBikesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBikesResponse) ProtoMessage() {}
+func (*ListBikesRequest) ProtoMessage() {}
 
-func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[6]
+func (x *ListBikesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,18 +927,81 @@ func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBikesResponse.ProtoReflect.Descriptor instead.
-func (*ListBikesResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListBikesRequest.ProtoReflect.Descriptor instead.
+func (*ListBikesRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListBikesResponse) GetBikes() []*Bike {
+func (x *ListBikesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBikesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBikesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bikes []*Bike `protobuf:"bytes,1,rep,name=bikes,proto3" json:"bikes,omitempty"`
+	// Empty if there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBikesResponse) Reset() {
+	*x = ListBikesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBikesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBikesResponse) ProtoMessage() {}
+
+func (x *ListBikesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBikesResponse.ProtoReflect.Descriptor instead.
+func (*ListBikesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBikesResponse) GetBikes() []*Bike {
 	if x != nil {
 		return x.Bikes
 	}
 	return nil
 }
 
+func (x *ListBikesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetBikeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -598,7 +1013,7 @@ type GetBikeRequest struct {
 func (x *GetBikeRequest) Reset() {
 	*x = GetBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +1026,7 @@ func (x *GetBikeRequest) String() string {
 func (*GetBikeRequest) ProtoMessage() {}
 
 func (x *GetBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[7]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +1039,7 @@ func (x *GetBikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBikeRequest.ProtoReflect.Descriptor instead.
 func (*GetBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{7}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetBikeRequest) GetId() string {
@@ -645,7 +1060,7 @@ type CreateBikeRequest struct {
 func (x *CreateBikeRequest) Reset() {
 	*x = CreateBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +1073,7 @@ func (x *CreateBikeRequest) String() string {
 func (*CreateBikeRequest) ProtoMessage() {}
 
 func (x *CreateBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[8]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +1086,7 @@ func (x *CreateBikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBikeRequest.ProtoReflect.Descriptor instead.
 func (*CreateBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{8}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateBikeRequest) GetData() *BikeData {
@@ -693,7 +1108,7 @@ type UpdateBikeRequest struct {
 func (x *UpdateBikeRequest) Reset() {
 	*x = UpdateBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +1121,7 @@ func (x *UpdateBikeRequest) String() string {
 func (*UpdateBikeRequest) ProtoMessage() {}
 
 func (x *UpdateBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[9]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1134,7 @@ func (x *UpdateBikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBikeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{9}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateBikeRequest) GetId() string {
@@ -747,7 +1162,7 @@ type DeleteBikeRequest struct {
 func (x *DeleteBikeRequest) Reset() {
 	*x = DeleteBikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +1175,7 @@ func (x *DeleteBikeRequest) String() string {
 func (*DeleteBikeRequest) ProtoMessage() {}
 
 func (x *DeleteBikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[10]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +1188,7 @@ func (x *DeleteBikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBikeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBikeRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{10}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteBikeRequest) GetId() string {
@@ -791,12 +1206,14 @@ type GetBikeAvailabilityRequest struct {
 	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
 	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional rental location.
+	Location *Location `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
 }
 
 func (x *GetBikeAvailabilityRequest) Reset() {
 	*x = GetBikeAvailabilityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -809,7 +1226,7 @@ func (x *GetBikeAvailabilityRequest) String() string {
 func (*GetBikeAvailabilityRequest) ProtoMessage() {}
 
 func (x *GetBikeAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[11]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1239,7 @@ func (x *GetBikeAvailabilityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBikeAvailabilityRequest.ProtoReflect.Descriptor instead.
 func (*GetBikeAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{11}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetBikeAvailabilityRequest) GetBikeId() string {
@@ -846,6 +1263,13 @@ func (x *GetBikeAvailabilityRequest) GetEndTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *GetBikeAvailabilityRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type GetBikeAvailabilityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -857,7 +1281,7 @@ type GetBikeAvailabilityResponse struct {
 func (x *GetBikeAvailabilityResponse) Reset() {
 	*x = GetBikeAvailabilityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -870,7 +1294,7 @@ func (x *GetBikeAvailabilityResponse) String() string {
 func (*GetBikeAvailabilityResponse) ProtoMessage() {}
 
 func (x *GetBikeAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[12]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1307,7 @@ func (x *GetBikeAvailabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBikeAvailabilityResponse.ProtoReflect.Descriptor instead.
 func (*GetBikeAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{12}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetBikeAvailabilityResponse) GetAvailable() bool {
@@ -903,12 +1327,14 @@ type CreateReservationRequest struct {
 	Location  *Location            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
 	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional promo code.
+	PromoCode string `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
 }
 
 func (x *CreateReservationRequest) Reset() {
 	*x = CreateReservationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -921,7 +1347,7 @@ func (x *CreateReservationRequest) String() string {
 func (*CreateReservationRequest) ProtoMessage() {}
 
 func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[13]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1360,7 @@ func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
 func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{13}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateReservationRequest) GetBikeId() string {
@@ -972,6 +1398,13 @@ func (x *CreateReservationRequest) GetEndTime() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *CreateReservationRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
 type CreateReservationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -985,7 +1418,7 @@ type CreateReservationResponse struct {
 func (x *CreateReservationResponse) Reset() {
 	*x = CreateReservationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -998,7 +1431,7 @@ func (x *CreateReservationResponse) String() string {
 func (*CreateReservationResponse) ProtoMessage() {}
 
 func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[14]
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1444,7 @@ func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
 func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{14}
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateReservationResponse) GetReservation() *Reservation {
@@ -1035,33 +1468,37 @@ func (x *CreateReservationResponse) GetReason() string {
 	return ""
 }
 
-type ListReservationsRequest struct {
+type UpdateReservationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
-	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	// Optional id of the bike to move reservation to. If empty, the bike is not changed.
+	NewBikeId string               `protobuf:"bytes,3,opt,name=new_bike_id,json=newBikeId,proto3" json:"new_bike_id,omitempty"`
+	Location  *Location            `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-func (x *ListReservationsRequest) Reset() {
-	*x = ListReservationsRequest{}
+func (x *UpdateReservationRequest) Reset() {
+	*x = UpdateReservationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListReservationsRequest) String() string {
+func (x *UpdateReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsRequest) ProtoMessage() {}
+func (*UpdateReservationRequest) ProtoMessage() {}
 
-func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[15]
+func (x *UpdateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,57 +1509,82 @@ func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
-func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateReservationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListReservationsRequest) GetBikeId() string {
+func (x *UpdateReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateReservationRequest) GetBikeId() string {
 	if x != nil {
 		return x.BikeId
 	}
 	return ""
 }
 
-func (x *ListReservationsRequest) GetStartTime() *timestamp.Timestamp {
+func (x *UpdateReservationRequest) GetNewBikeId() string {
+	if x != nil {
+		return x.NewBikeId
+	}
+	return ""
+}
+
+func (x *UpdateReservationRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateReservationRequest) GetStartTime() *timestamp.Timestamp {
 	if x != nil {
 		return x.StartTime
 	}
 	return nil
 }
 
-func (x *ListReservationsRequest) GetEndTime() *timestamp.Timestamp {
+func (x *UpdateReservationRequest) GetEndTime() *timestamp.Timestamp {
 	if x != nil {
 		return x.EndTime
 	}
 	return nil
 }
 
-type ListReservationsResponse struct {
+type UpdateReservationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reservations []*Reservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Reservation *Reservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Status      ReservationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
+	Reason      string            `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Change of reservation total value. Negative value is refunded to the customer.
+	PriceDifference int32 `protobuf:"varint,4,opt,name=price_difference,json=priceDifference,proto3" json:"price_difference,omitempty"`
 }
 
-func (x *ListReservationsResponse) Reset() {
-	*x = ListReservationsResponse{}
+func (x *UpdateReservationResponse) Reset() {
+	*x = UpdateReservationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListReservationsResponse) String() string {
+func (x *UpdateReservationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsResponse) ProtoMessage() {}
+func (*UpdateReservationResponse) ProtoMessage() {}
 
-func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[16]
+func (x *UpdateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,44 +1595,68 @@ func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
-func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateReservationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListReservationsResponse) GetReservations() []*Reservation {
+func (x *UpdateReservationResponse) GetReservation() *Reservation {
 	if x != nil {
-		return x.Reservations
+		return x.Reservation
 	}
 	return nil
 }
 
-type CancelReservationRequest struct {
+func (x *UpdateReservationResponse) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+}
+
+func (x *UpdateReservationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateReservationResponse) GetPriceDifference() int32 {
+	if x != nil {
+		return x.PriceDifference
+	}
+	return 0
+}
+
+type CreateGroupReservationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	BikeIds   []string             `protobuf:"bytes,1,rep,name=bike_ids,json=bikeIds,proto3" json:"bike_ids,omitempty"`
+	Customer  *Customer            `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Location  *Location            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-func (x *CancelReservationRequest) Reset() {
-	*x = CancelReservationRequest{}
+func (x *CreateGroupReservationRequest) Reset() {
+	*x = CreateGroupReservationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelReservationRequest) String() string {
+func (x *CreateGroupReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelReservationRequest) ProtoMessage() {}
+func (*CreateGroupReservationRequest) ProtoMessage() {}
 
-func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[17]
+func (x *CreateGroupReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,954 +1667,6759 @@ func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
-func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
-	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateGroupReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CancelReservationRequest) GetId() string {
+func (x *CreateGroupReservationRequest) GetBikeIds() []string {
 	if x != nil {
-		return x.Id
+		return x.BikeIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelReservationRequest) GetBikeId() string {
+func (x *CreateGroupReservationRequest) GetCustomer() *Customer {
 	if x != nil {
-		return x.BikeId
+		return x.Customer
 	}
-	return ""
+	return nil
 }
 
-var File_nglogic_bikerental_v1_service_proto protoreflect.FileDescriptor
+func (x *CreateGroupReservationRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
 
-var file_nglogic_bikerental_v1_service_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x04, 0x42, 0x69, 0x6b, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x64, 0x0a, 0x08, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x22, 0x53, 0x0a, 0x08, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
-	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x96, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x89, 0x03, 0x0a, 0x0b, 0x52, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x69, 0x6b, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03,
-	0x6c, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05,
-	0x62, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67,
-	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x22,
-	0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x48, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
-	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x22, 0x9f, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
-	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c,
-	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x18,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49,
-	0x64, 0x2a, 0x63, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
-	0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x55,
-	0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x53, 0x49,
-	0x4e, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
-	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
-	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
-	0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20,
-	0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03,
-	0x32, 0xb4, 0x09, 0x0a, 0x11, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
-	0x6b, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x6e, 0x67,
-	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f,
-	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x12, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
-	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c,
-	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
-	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a,
-	0x7d, 0x12, 0x6c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12,
-	0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
-	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x09,
-	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x68, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6e, 0x0a, 0x0a, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
-	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x3d, 0x2a, 0x7d, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
-	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b,
-	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
-	0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b,
-	0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62,
-	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e,
-	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
-	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96,
-	0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
-	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x38, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73,
-	0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d,
-	0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x67, 0x6f,
-	0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x67, 0x75, 0x69,
-	0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72,
-	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x76, 0x31, 0x3b, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
-	0x61, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CreateGroupReservationRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-var (
-	file_nglogic_bikerental_v1_service_proto_rawDescOnce sync.Once
-	file_nglogic_bikerental_v1_service_proto_rawDescData = file_nglogic_bikerental_v1_service_proto_rawDesc
-)
+func (x *CreateGroupReservationRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
 
-func file_nglogic_bikerental_v1_service_proto_rawDescGZIP() []byte {
-	file_nglogic_bikerental_v1_service_proto_rawDescOnce.Do(func() {
-		file_nglogic_bikerental_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_nglogic_bikerental_v1_service_proto_rawDescData)
-	})
-	return file_nglogic_bikerental_v1_service_proto_rawDescData
+type CreateGroupReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       ReservationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
+	Reason       string            `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	GroupId      string            `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Reservations []*Reservation    `protobuf:"bytes,4,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	// Discount applied to combined value of all reservations.
+	Discount *Discount `protobuf:"bytes,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Bikes already reserved in requested time range.
+	UnavailableBikeIds []string `protobuf:"bytes,6,rep,name=unavailable_bike_ids,json=unavailableBikeIds,proto3" json:"unavailable_bike_ids,omitempty"`
 }
 
-var file_nglogic_bikerental_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_nglogic_bikerental_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_nglogic_bikerental_v1_service_proto_goTypes = []interface{}{
-	(CustomerType)(0),                   // 0: nglogic.bikerental.v1.CustomerType
-	(ReservationStatus)(0),              // 1: nglogic.bikerental.v1.ReservationStatus
-	(*Bike)(nil),                        // 2: nglogic.bikerental.v1.Bike
-	(*BikeData)(nil),                    // 3: nglogic.bikerental.v1.BikeData
-	(*Customer)(nil),                    // 4: nglogic.bikerental.v1.Customer
-	(*CustomerData)(nil),                // 5: nglogic.bikerental.v1.CustomerData
-	(*Reservation)(nil),                 // 6: nglogic.bikerental.v1.Reservation
-	(*Location)(nil),                    // 7: nglogic.bikerental.v1.Location
-	(*ListBikesResponse)(nil),           // 8: nglogic.bikerental.v1.ListBikesResponse
-	(*GetBikeRequest)(nil),              // 9: nglogic.bikerental.v1.GetBikeRequest
-	(*CreateBikeRequest)(nil),           // 10: nglogic.bikerental.v1.CreateBikeRequest
-	(*UpdateBikeRequest)(nil),           // 11: nglogic.bikerental.v1.UpdateBikeRequest
-	(*DeleteBikeRequest)(nil),           // 12: nglogic.bikerental.v1.DeleteBikeRequest
-	(*GetBikeAvailabilityRequest)(nil),  // 13: nglogic.bikerental.v1.GetBikeAvailabilityRequest
-	(*GetBikeAvailabilityResponse)(nil), // 14: nglogic.bikerental.v1.GetBikeAvailabilityResponse
-	(*CreateReservationRequest)(nil),    // 15: nglogic.bikerental.v1.CreateReservationRequest
-	(*CreateReservationResponse)(nil),   // 16: nglogic.bikerental.v1.CreateReservationResponse
-	(*ListReservationsRequest)(nil),     // 17: nglogic.bikerental.v1.ListReservationsRequest
-	(*ListReservationsResponse)(nil),    // 18: nglogic.bikerental.v1.ListReservationsResponse
-	(*CancelReservationRequest)(nil),    // 19: nglogic.bikerental.v1.CancelReservationRequest
-	(*timestamp.Timestamp)(nil),         // 20: google.protobuf.Timestamp
-	(*empty.Empty)(nil),                 // 21: google.protobuf.Empty
+func (x *CreateGroupReservationResponse) Reset() {
+	*x = CreateGroupReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_nglogic_bikerental_v1_service_proto_depIdxs = []int32{
-	3,  // 0: nglogic.bikerental.v1.Bike.data:type_name -> nglogic.bikerental.v1.BikeData
-	5,  // 1: nglogic.bikerental.v1.Customer.data:type_name -> nglogic.bikerental.v1.CustomerData
-	0,  // 2: nglogic.bikerental.v1.CustomerData.type:type_name -> nglogic.bikerental.v1.CustomerType
-	1,  // 3: nglogic.bikerental.v1.Reservation.status:type_name -> nglogic.bikerental.v1.ReservationStatus
-	4,  // 4: nglogic.bikerental.v1.Reservation.customer:type_name -> nglogic.bikerental.v1.Customer
-	2,  // 5: nglogic.bikerental.v1.Reservation.bike:type_name -> nglogic.bikerental.v1.Bike
-	20, // 6: nglogic.bikerental.v1.Reservation.start_time:type_name -> google.protobuf.Timestamp
-	20, // 7: nglogic.bikerental.v1.Reservation.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 8: nglogic.bikerental.v1.ListBikesResponse.bikes:type_name -> nglogic.bikerental.v1.Bike
-	3,  // 9: nglogic.bikerental.v1.CreateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
-	3,  // 10: nglogic.bikerental.v1.UpdateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
-	20, // 11: nglogic.bikerental.v1.GetBikeAvailabilityRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 12: nglogic.bikerental.v1.GetBikeAvailabilityRequest.end_time:type_name -> google.protobuf.Timestamp
-	4,  // 13: nglogic.bikerental.v1.CreateReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
-	7,  // 14: nglogic.bikerental.v1.CreateReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
-	20, // 15: nglogic.bikerental.v1.CreateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 16: nglogic.bikerental.v1.CreateReservationRequest.end_time:type_name -> google.protobuf.Timestamp
-	6,  // 17: nglogic.bikerental.v1.CreateReservationResponse.reservation:type_name -> nglogic.bikerental.v1.Reservation
-	1,  // 18: nglogic.bikerental.v1.CreateReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
-	20, // 19: nglogic.bikerental.v1.ListReservationsRequest.start_time:type_name -> google.protobuf.Timestamp
-	20, // 20: nglogic.bikerental.v1.ListReservationsRequest.end_time:type_name -> google.protobuf.Timestamp
-	6,  // 21: nglogic.bikerental.v1.ListReservationsResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
-	21, // 22: nglogic.bikerental.v1.BikeRentalService.ListBikes:input_type -> google.protobuf.Empty
-	9,  // 23: nglogic.bikerental.v1.BikeRentalService.GetBike:input_type -> nglogic.bikerental.v1.GetBikeRequest
-	10, // 24: nglogic.bikerental.v1.BikeRentalService.CreateBike:input_type -> nglogic.bikerental.v1.CreateBikeRequest
-	12, // 25: nglogic.bikerental.v1.BikeRentalService.DeleteBike:input_type -> nglogic.bikerental.v1.DeleteBikeRequest
-	11, // 26: nglogic.bikerental.v1.BikeRentalService.UpdateBike:input_type -> nglogic.bikerental.v1.UpdateBikeRequest
-	13, // 27: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:input_type -> nglogic.bikerental.v1.GetBikeAvailabilityRequest
-	17, // 28: nglogic.bikerental.v1.BikeRentalService.ListReservations:input_type -> nglogic.bikerental.v1.ListReservationsRequest
-	15, // 29: nglogic.bikerental.v1.BikeRentalService.CreateReservation:input_type -> nglogic.bikerental.v1.CreateReservationRequest
-	19, // 30: nglogic.bikerental.v1.BikeRentalService.CancelReservation:input_type -> nglogic.bikerental.v1.CancelReservationRequest
-	8,  // 31: nglogic.bikerental.v1.BikeRentalService.ListBikes:output_type -> nglogic.bikerental.v1.ListBikesResponse
-	2,  // 32: nglogic.bikerental.v1.BikeRentalService.GetBike:output_type -> nglogic.bikerental.v1.Bike
-	2,  // 33: nglogic.bikerental.v1.BikeRentalService.CreateBike:output_type -> nglogic.bikerental.v1.Bike
-	21, // 34: nglogic.bikerental.v1.BikeRentalService.DeleteBike:output_type -> google.protobuf.Empty
-	21, // 35: nglogic.bikerental.v1.BikeRentalService.UpdateBike:output_type -> google.protobuf.Empty
-	14, // 36: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:output_type -> nglogic.bikerental.v1.GetBikeAvailabilityResponse
-	18, // 37: nglogic.bikerental.v1.BikeRentalService.ListReservations:output_type -> nglogic.bikerental.v1.ListReservationsResponse
-	16, // 38: nglogic.bikerental.v1.BikeRentalService.CreateReservation:output_type -> nglogic.bikerental.v1.CreateReservationResponse
-	21, // 39: nglogic.bikerental.v1.BikeRentalService.CancelReservation:output_type -> google.protobuf.Empty
-	31, // [31:40] is the sub-list for method output_type
-	22, // [22:31] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+
+func (x *CreateGroupReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_nglogic_bikerental_v1_service_proto_init() }
-func file_nglogic_bikerental_v1_service_proto_init() {
-	if File_nglogic_bikerental_v1_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_nglogic_bikerental_v1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bike); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*CreateGroupReservationResponse) ProtoMessage() {}
+
+func (x *CreateGroupReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BikeData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupReservationResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateGroupReservationResponse) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+}
+
+func (x *CreateGroupReservationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateGroupReservationResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateGroupReservationResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *CreateGroupReservationResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CreateGroupReservationResponse) GetUnavailableBikeIds() []string {
+	if x != nil {
+		return x.UnavailableBikeIds
+	}
+	return nil
+}
+
+type CreateRecurringReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId   string    `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Customer *Customer `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Location *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Start and end time of the first occurrence.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// RRULE-like recurrence pattern, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+	// Supported parts are FREQ (DAILY or WEEKLY), INTERVAL and BYDAY.
+	Rule string `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	// The latest start time of an occurrence.
+	Until *timestamp.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	// IANA time zone name, in which occurrences keep the same local time. Default is UTC.
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *CreateRecurringReservationRequest) Reset() {
+	*x = CreateRecurringReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringReservationRequest) ProtoMessage() {}
+
+func (x *CreateRecurringReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Customer); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRecurringReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *CreateRecurringReservationRequest) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *CreateRecurringReservationRequest) GetUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type Occurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *Occurrence) Reset() {
+	*x = Occurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Occurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Occurrence) ProtoMessage() {}
+
+func (x *Occurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomerData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reservation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Location); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBikesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Occurrence.ProtoReflect.Descriptor instead.
+func (*Occurrence) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Occurrence) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Occurrence) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateRecurringReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       ReservationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=nglogic.bikerental.v1.ReservationStatus" json:"status,omitempty"`
+	Reason       string            `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	SeriesId     string            `protobuf:"bytes,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Reservations []*Reservation    `protobuf:"bytes,4,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	// Occurrences for which the bike is not available.
+	Conflicts []*Occurrence `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *CreateRecurringReservationResponse) Reset() {
+	*x = CreateRecurringReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringReservationResponse) ProtoMessage() {}
+
+func (x *CreateRecurringReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringReservationResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateRecurringReservationResponse) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNKNOWN
+}
+
+func (x *CreateRecurringReservationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateRecurringReservationResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CreateRecurringReservationResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *CreateRecurringReservationResponse) GetConflicts() []*Occurrence {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CancelReservationSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	BikeId   string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CancelReservationSeriesRequest) Reset() {
+	*x = CancelReservationSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationSeriesRequest) ProtoMessage() {}
+
+func (x *CancelReservationSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelReservationSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CancelReservationSeriesRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type CancelReservationSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of canceled reservations.
+	Canceled int32 `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+}
+
+func (x *CancelReservationSeriesResponse) Reset() {
+	*x = CancelReservationSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationSeriesResponse) ProtoMessage() {}
+
+func (x *CancelReservationSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBikeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelReservationSeriesResponse) GetCanceled() int32 {
+	if x != nil {
+		return x.Canceled
+	}
+	return 0
+}
+
+type SearchAvailableBikesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional rental location.
+	Location *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Search radius in meters around location. Server default is used if not set.
+	Radius float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	// Optional filters.
+	MinWeight       float32 `protobuf:"fixed32,5,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`
+	MaxWeight       float32 `protobuf:"fixed32,6,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxPricePerHour int32   `protobuf:"varint,7,opt,name=max_price_per_hour,json=maxPricePerHour,proto3" json:"max_price_per_hour,omitempty"`
+	// Maximum number of bikes to return, default is 10, maximum is 100.
+	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *SearchAvailableBikesRequest) Reset() {
+	*x = SearchAvailableBikesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAvailableBikesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailableBikesRequest) ProtoMessage() {}
+
+func (x *SearchAvailableBikesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeAvailabilityRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailableBikesRequest.ProtoReflect.Descriptor instead.
+func (*SearchAvailableBikesRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchAvailableBikesRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SearchAvailableBikesRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SearchAvailableBikesRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SearchAvailableBikesRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SearchAvailableBikesRequest) GetMinWeight() float32 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *SearchAvailableBikesRequest) GetMaxWeight() float32 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *SearchAvailableBikesRequest) GetMaxPricePerHour() int32 {
+	if x != nil {
+		return x.MaxPricePerHour
+	}
+	return 0
+}
+
+func (x *SearchAvailableBikesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchAvailableBikesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bikes []*AvailableBike `protobuf:"bytes,1,rep,name=bikes,proto3" json:"bikes,omitempty"`
+}
+
+func (x *SearchAvailableBikesResponse) Reset() {
+	*x = SearchAvailableBikesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAvailableBikesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailableBikesResponse) ProtoMessage() {}
+
+func (x *SearchAvailableBikesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBikeAvailabilityResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailableBikesResponse.ProtoReflect.Descriptor instead.
+func (*SearchAvailableBikesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchAvailableBikesResponse) GetBikes() []*AvailableBike {
+	if x != nil {
+		return x.Bikes
+	}
+	return nil
+}
+
+type AvailableBike struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bike *Bike `protobuf:"bytes,1,opt,name=bike,proto3" json:"bike,omitempty"`
+	// Distance in meters between bike station and requested location. Zero if location wasn't set.
+	Distance float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *AvailableBike) Reset() {
+	*x = AvailableBike{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableBike) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableBike) ProtoMessage() {}
+
+func (x *AvailableBike) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReservationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableBike.ProtoReflect.Descriptor instead.
+func (*AvailableBike) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AvailableBike) GetBike() *Bike {
+	if x != nil {
+		return x.Bike
+	}
+	return nil
+}
+
+func (x *AvailableBike) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum number of reservations to return, default is 10, maximum is 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from previous response, used to fetch the next page.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReservationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListReservationsRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservations []*Reservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	// Empty if there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReservationsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *ListReservationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListReservationsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CancelReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Amount returned to the customer.
+	Refund int32 `protobuf:"varint,2,opt,name=refund,proto3" json:"refund,omitempty"`
+	// Amount kept as cancellation fee.
+	Fee        int32                `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	CanceledAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_nglogic_bikerental_v1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelReservationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelReservationResponse) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *CancelReservationResponse) GetRefund() int32 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *CancelReservationResponse) GetFee() int32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *CancelReservationResponse) GetCanceledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+type ConfirmReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *ConfirmReservationRequest) Reset() {
+	*x = ConfirmReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReservationRequest) ProtoMessage() {}
+
+func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConfirmReservationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfirmReservationRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type StartRentalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *StartRentalRequest) Reset() {
+	*x = StartRentalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRentalRequest) ProtoMessage() {}
+
+func (x *StartRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRentalRequest.ProtoReflect.Descriptor instead.
+func (*StartRentalRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartRentalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StartRentalRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type CompleteRentalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CompleteRentalRequest) Reset() {
+	*x = CompleteRentalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRentalRequest) ProtoMessage() {}
+
+func (x *CompleteRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRentalRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRentalRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CompleteRentalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteRentalRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type MarkNoShowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *MarkNoShowRequest) Reset() {
+	*x = MarkNoShowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowRequest) ProtoMessage() {}
+
+func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkNoShowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkNoShowRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status    WaitlistStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=nglogic.bikerental.v1.WaitlistStatus" json:"status,omitempty"`
+	Customer  *Customer            `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	BikeId    string               `protobuf:"bytes,4,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Location  *Location            `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Values of the reservation created after promotion.
+	TotalValue      int32  `protobuf:"varint,8,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	AppliedDiscount int32  `protobuf:"varint,9,opt,name=applied_discount,json=appliedDiscount,proto3" json:"applied_discount,omitempty"`
+	DiscountRule    string `protobuf:"bytes,10,opt,name=discount_rule,json=discountRule,proto3" json:"discount_rule,omitempty"`
+	// Reservation created when the entry was promoted.
+	ReservationId string               `protobuf:"bytes,11,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStatus() WaitlistStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNKNOWN
+}
+
+func (x *WaitlistEntry) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetTotalValue() int32 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetAppliedDiscount() int32 {
+	if x != nil {
+		return x.AppliedDiscount
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetDiscountRule() string {
+	if x != nil {
+		return x.DiscountRule
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId string `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *ListWaitlistRequest) Reset() {
+	*x = ListWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistRequest) ProtoMessage() {}
+
+func (x *ListWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListWaitlistRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type ListWaitlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListWaitlistResponse) Reset() {
+	*x = ListWaitlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistResponse) ProtoMessage() {}
+
+func (x *ListWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type WithdrawWaitlistEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *WithdrawWaitlistEntryRequest) Reset() {
+	*x = WithdrawWaitlistEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *WithdrawWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WithdrawWaitlistEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithdrawWaitlistEntryRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId    string               `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason    string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListMaintenanceRequest) Reset() {
+	*x = ListMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMaintenanceRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *ListMaintenanceRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListMaintenanceRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ListMaintenanceResponse) Reset() {
+	*x = ListMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMaintenanceResponse) GetWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type ScheduleMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason    string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ScheduleMaintenanceRequest) Reset() {
+	*x = ScheduleMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScheduleMaintenanceRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScheduleMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty if maintenance wasn't scheduled.
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Reservations overlapping with requested maintenance window.
+	Conflicts []*Reservation `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *ScheduleMaintenanceResponse) Reset() {
+	*x = ScheduleMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceResponse) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ScheduleMaintenanceResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceResponse) GetConflicts() []*Reservation {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CancelMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *CancelMaintenanceRequest) Reset() {
+	*x = CancelMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CancelMaintenanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelMaintenanceRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reservation id.
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BikeId string `protobuf:"bytes,2,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetInvoiceRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+// Invoice of a completed rental. Values are in euro-cents.
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReservationId string `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Reservation value for scheduled time, before discount.
+	ReservationValue int32 `protobuf:"varint,2,opt,name=reservation_value,json=reservationValue,proto3" json:"reservation_value,omitempty"`
+	Discount         int32 `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Penalty for late bike return.
+	LateFee int32 `protobuf:"varint,4,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`
+	// Number of started hours of late return.
+	LateHours int32 `protobuf:"varint,5,opt,name=late_hours,json=lateHours,proto3" json:"late_hours,omitempty"`
+	// Final amount to pay.
+	TotalValue int32                `protobuf:"varint,6,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	CreatedAt  *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Invoice) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Invoice) GetReservationValue() int32 {
+	if x != nil {
+		return x.ReservationValue
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Invoice) GetLateFee() int32 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *Invoice) GetLateHours() int32 {
+	if x != nil {
+		return x.LateHours
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotalValue() int32 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListStationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+}
+
+func (x *ListStationsResponse) Reset() {
+	*x = ListStationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStationsResponse) ProtoMessage() {}
+
+func (x *ListStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStationsResponse.ProtoReflect.Descriptor instead.
+func (*ListStationsResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListStationsResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type GetStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetStationRequest) Reset() {
+	*x = GetStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStationRequest) ProtoMessage() {}
+
+func (x *GetStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStationRequest.ProtoReflect.Descriptor instead.
+func (*GetStationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetStationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *StationData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateStationRequest) Reset() {
+	*x = CreateStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStationRequest) ProtoMessage() {}
+
+func (x *CreateStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStationRequest.ProtoReflect.Descriptor instead.
+func (*CreateStationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateStationRequest) GetData() *StationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *StationData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateStationRequest) Reset() {
+	*x = UpdateStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStationRequest) ProtoMessage() {}
+
+func (x *UpdateStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateStationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateStationRequest) GetData() *StationData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteStationRequest) Reset() {
+	*x = DeleteStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStationRequest) ProtoMessage() {}
+
+func (x *DeleteStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStationRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteStationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCustomersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filter, matched case-insensitively.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Optional filter.
+	Type CustomerType `protobuf:"varint,2,opt,name=type,proto3,enum=nglogic.bikerental.v1.CustomerType" json:"type,omitempty"`
+	// Maximum number of customers to return, default is 10, maximum is 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from previous response, used to fetch the next page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListCustomersRequest) Reset() {
+	*x = ListCustomersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersRequest) ProtoMessage() {}
+
+func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCustomersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetType() CustomerType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomerType_CUSTOMER_TYPE_UNKNOWN
+}
+
+func (x *ListCustomersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCustomersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Customers []*Customer `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	// Empty if there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCustomersResponse) Reset() {
+	*x = ListCustomersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersResponse) ProtoMessage() {}
+
+func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *ListCustomersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *CustomerData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateCustomerRequest) GetData() *CustomerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data *CustomerData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetData() *CustomerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteCustomerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteCustomerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Discount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Amount in euro-cents.
+	Amount int32 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Identifier of the rule that produced the discount. Empty if no discount applies.
+	Rule string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Human-readable explanation of the discount.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Discount) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Discount) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Discount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CheckDiscountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BikeId    string               `protobuf:"bytes,1,opt,name=bike_id,json=bikeId,proto3" json:"bike_id,omitempty"`
+	Customer  *Customer            `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Location  *Location            `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CheckDiscountRequest) Reset() {
+	*x = CheckDiscountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDiscountRequest) ProtoMessage() {}
+
+func (x *CheckDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDiscountRequest.ProtoReflect.Descriptor instead.
+func (*CheckDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CheckDiscountRequest) GetBikeId() string {
+	if x != nil {
+		return x.BikeId
+	}
+	return ""
+}
+
+func (x *CheckDiscountRequest) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CheckDiscountRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CheckDiscountRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CheckDiscountRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CheckDiscountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reservation value before discount in euro-cents.
+	ReservationValue int32     `protobuf:"varint,1,opt,name=reservation_value,json=reservationValue,proto3" json:"reservation_value,omitempty"`
+	Discount         *Discount `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	// All evaluated discount rules, including those that didn't apply.
+	Candidates []*Discount `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *CheckDiscountResponse) Reset() {
+	*x = CheckDiscountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDiscountResponse) ProtoMessage() {}
+
+func (x *CheckDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDiscountResponse.ProtoReflect.Descriptor instead.
+func (*CheckDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CheckDiscountResponse) GetReservationValue() int32 {
+	if x != nil {
+		return x.ReservationValue
+	}
+	return 0
+}
+
+func (x *CheckDiscountResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CheckDiscountResponse) GetCandidates() []*Discount {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type PromoCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Percent of reservation value to discount. Only one of percent or amount can be set.
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Fixed discount in euro-cents.
+	Amount    int32                `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ValidFrom *timestamp.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	// Total redemptions limit, 0 means no limit.
+	MaxRedemptions int32 `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	// Redemptions limit per customer, 0 means no limit.
+	MaxRedemptionsPerCustomer int32 `protobuf:"varint,7,opt,name=max_redemptions_per_customer,json=maxRedemptionsPerCustomer,proto3" json:"max_redemptions_per_customer,omitempty"`
+	// Stackable code is added to the discount from discount rules, otherwise the greater discount is applied.
+	Stackable bool `protobuf:"varint,8,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	Disabled  bool `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Number of times the code was redeemed. Read only.
+	Redemptions int32 `protobuf:"varint,10,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PromoCode) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PromoCode) GetValidFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *PromoCode) GetValidTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxRedemptionsPerCustomer() int32 {
+	if x != nil {
+		return x.MaxRedemptionsPerCustomer
+	}
+	return 0
+}
+
+func (x *PromoCode) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *PromoCode) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *PromoCode) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCodes []*PromoCode `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCode *PromoCode `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type DisablePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *DisablePromoCodeRequest) Reset() {
+	*x = DisablePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisablePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePromoCodeRequest) ProtoMessage() {}
+
+func (x *DisablePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nglogic_bikerental_v1_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DisablePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_nglogic_bikerental_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DisablePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+var File_nglogic_bikerental_v1_service_proto protoreflect.FileDescriptor
+
+var file_nglogic_bikerental_v1_service_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x04, 0x42, 0x69, 0x6b, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe3, 0x01, 0x0a, 0x08, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x07, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7a,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x53, 0x0a, 0x08, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x96, 0x01, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc2, 0x06, 0x0a, 0x0b, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x6b, 0x65, 0x52, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x75, 0x70, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x65, 0x64,
+	0x55, 0x70, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x68, 0x6f, 0x6c, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x30, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x22,
+	0x4e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x6e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65,
+	0x52, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x48, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x3b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xbe,
+	0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62,
+	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69,
+	0x6b, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xbb, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x92, 0x02,
+	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69,
+	0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b,
+	0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x69, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x69, 0x6b,
+	0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x1d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0x8b, 0x03, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12,
+	0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x22, 0x7e, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xa4, 0x02, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12,
+	0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64,
+	0x22, 0x3d, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x22,
+	0xec, 0x02, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5a,
+	0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x69, 0x6b, 0x65, 0x52, 0x05, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0d, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x62,
+	0x69, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x04, 0x62, 0x69, 0x6b, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x43, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0xa9, 0x01,
+	0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x19, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22,
+	0x3d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0x40,
+	0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64,
+	0x22, 0x3c, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0xb6,
+	0x04, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3b, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x47, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x1b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x18,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49,
+	0x64, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x22,
+	0x8f, 0x02, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5e, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x52, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x60,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x08, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x14, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6b, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x08,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x89, 0x03, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x54, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x64,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x2d, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a,
+	0x76, 0x0a, 0x0a, 0x42, 0x69, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a,
+	0x13, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x54, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0xb8, 0x02, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f,
+	0x5f, 0x53, 0x48, 0x4f, 0x57, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41,
+	0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x41, 0x49, 0x54, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x41, 0x49, 0x54, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10,
+	0x03, 0x32, 0xa1, 0x2d, 0x0a, 0x11, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12,
+	0x09, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x25, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x3d, 0x2a, 0x7d, 0x12, 0x6c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b,
+	0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6b, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x68, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12,
+	0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6e, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x1a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa8, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x69, 0x6b, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62,
+	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x9c, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x12,
+	0x32, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6b, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x3a, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b,
+	0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69,
+	0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0xac, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
+	0x1a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b,
+	0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xa1,
+	0x01, 0x0a, 0x08, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x69, 0x6b, 0x65, 0x12, 0x2f, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e,
+	0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65,
+	0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x68, 0x6f, 0x6c, 0x64, 0x3a,
+	0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x22, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
+	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x3a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0xa8, 0x01, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0xca, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x31, 0x22, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69,
+	0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x3a,
+	0x01, 0x2a, 0x12, 0xce, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73,
+	0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0xb0, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x32, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f,
+	0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x95, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x29, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x9e,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x12, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x32, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x98, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x28,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x36, 0x22, 0x34, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f,
+	0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a,
+	0x6d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x91, 0x01, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x90,
+	0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x2a, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b,
+	0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b,
+	0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x33, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x57, 0x61,
+	0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62,
+	0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x9b, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69,
+	0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0xaa, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b,
+	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01,
+	0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f,
+	0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x69, 0x6b, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x69, 0x6b, 0x65, 0x5f, 0x69, 0x64, 0x3d, 0x2a,
+	0x7d, 0x3a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
+	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d,
+	0x2a, 0x7d, 0x12, 0x78, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69,
+	0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x77, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e,
+	0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x71, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x81, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x77, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x6e, 0x67,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63,
+	0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x7c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69,
+	0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x0d,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x3a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x7a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e,
+	0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x3a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x74, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x12, 0x2c, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65,
+	0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x3d, 0x2a, 0x7d, 0x12, 0x6f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x2d, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x6e, 0x67, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x67, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x2e, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x3a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x83, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x62,
+	0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x64, 0x65, 0x3d, 0x2a, 0x7d, 0x3a, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x67, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x67, 0x6f, 0x2d, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x67, 0x75, 0x69, 0x64, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x76, 0x31, 0x3b, 0x62, 0x69, 0x6b, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nglogic_bikerental_v1_service_proto_rawDescOnce sync.Once
+	file_nglogic_bikerental_v1_service_proto_rawDescData = file_nglogic_bikerental_v1_service_proto_rawDesc
+)
+
+func file_nglogic_bikerental_v1_service_proto_rawDescGZIP() []byte {
+	file_nglogic_bikerental_v1_service_proto_rawDescOnce.Do(func() {
+		file_nglogic_bikerental_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_nglogic_bikerental_v1_service_proto_rawDescData)
+	})
+	return file_nglogic_bikerental_v1_service_proto_rawDescData
+}
+
+var file_nglogic_bikerental_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_nglogic_bikerental_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_nglogic_bikerental_v1_service_proto_goTypes = []interface{}{
+	(BikeStatus)(0),                            // 0: nglogic.bikerental.v1.BikeStatus
+	(CustomerType)(0),                          // 1: nglogic.bikerental.v1.CustomerType
+	(ReservationStatus)(0),                     // 2: nglogic.bikerental.v1.ReservationStatus
+	(WaitlistStatus)(0),                        // 3: nglogic.bikerental.v1.WaitlistStatus
+	(*Bike)(nil),                               // 4: nglogic.bikerental.v1.Bike
+	(*BikeData)(nil),                           // 5: nglogic.bikerental.v1.BikeData
+	(*Station)(nil),                            // 6: nglogic.bikerental.v1.Station
+	(*StationData)(nil),                        // 7: nglogic.bikerental.v1.StationData
+	(*Customer)(nil),                           // 8: nglogic.bikerental.v1.Customer
+	(*CustomerData)(nil),                       // 9: nglogic.bikerental.v1.CustomerData
+	(*Reservation)(nil),                        // 10: nglogic.bikerental.v1.Reservation
+	(*Location)(nil),                           // 11: nglogic.bikerental.v1.Location
+	(*ListBikesRequest)(nil),                   // 12: nglogic.bikerental.v1.ListBikesRequest
+	(*ListBikesResponse)(nil),                  // 13: nglogic.bikerental.v1.ListBikesResponse
+	(*GetBikeRequest)(nil),                     // 14: nglogic.bikerental.v1.GetBikeRequest
+	(*CreateBikeRequest)(nil),                  // 15: nglogic.bikerental.v1.CreateBikeRequest
+	(*UpdateBikeRequest)(nil),                  // 16: nglogic.bikerental.v1.UpdateBikeRequest
+	(*DeleteBikeRequest)(nil),                  // 17: nglogic.bikerental.v1.DeleteBikeRequest
+	(*GetBikeAvailabilityRequest)(nil),         // 18: nglogic.bikerental.v1.GetBikeAvailabilityRequest
+	(*GetBikeAvailabilityResponse)(nil),        // 19: nglogic.bikerental.v1.GetBikeAvailabilityResponse
+	(*CreateReservationRequest)(nil),           // 20: nglogic.bikerental.v1.CreateReservationRequest
+	(*CreateReservationResponse)(nil),          // 21: nglogic.bikerental.v1.CreateReservationResponse
+	(*UpdateReservationRequest)(nil),           // 22: nglogic.bikerental.v1.UpdateReservationRequest
+	(*UpdateReservationResponse)(nil),          // 23: nglogic.bikerental.v1.UpdateReservationResponse
+	(*CreateGroupReservationRequest)(nil),      // 24: nglogic.bikerental.v1.CreateGroupReservationRequest
+	(*CreateGroupReservationResponse)(nil),     // 25: nglogic.bikerental.v1.CreateGroupReservationResponse
+	(*CreateRecurringReservationRequest)(nil),  // 26: nglogic.bikerental.v1.CreateRecurringReservationRequest
+	(*Occurrence)(nil),                         // 27: nglogic.bikerental.v1.Occurrence
+	(*CreateRecurringReservationResponse)(nil), // 28: nglogic.bikerental.v1.CreateRecurringReservationResponse
+	(*CancelReservationSeriesRequest)(nil),     // 29: nglogic.bikerental.v1.CancelReservationSeriesRequest
+	(*CancelReservationSeriesResponse)(nil),    // 30: nglogic.bikerental.v1.CancelReservationSeriesResponse
+	(*SearchAvailableBikesRequest)(nil),        // 31: nglogic.bikerental.v1.SearchAvailableBikesRequest
+	(*SearchAvailableBikesResponse)(nil),       // 32: nglogic.bikerental.v1.SearchAvailableBikesResponse
+	(*AvailableBike)(nil),                      // 33: nglogic.bikerental.v1.AvailableBike
+	(*ListReservationsRequest)(nil),            // 34: nglogic.bikerental.v1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),           // 35: nglogic.bikerental.v1.ListReservationsResponse
+	(*CancelReservationRequest)(nil),           // 36: nglogic.bikerental.v1.CancelReservationRequest
+	(*CancelReservationResponse)(nil),          // 37: nglogic.bikerental.v1.CancelReservationResponse
+	(*ConfirmReservationRequest)(nil),          // 38: nglogic.bikerental.v1.ConfirmReservationRequest
+	(*StartRentalRequest)(nil),                 // 39: nglogic.bikerental.v1.StartRentalRequest
+	(*CompleteRentalRequest)(nil),              // 40: nglogic.bikerental.v1.CompleteRentalRequest
+	(*MarkNoShowRequest)(nil),                  // 41: nglogic.bikerental.v1.MarkNoShowRequest
+	(*WaitlistEntry)(nil),                      // 42: nglogic.bikerental.v1.WaitlistEntry
+	(*ListWaitlistRequest)(nil),                // 43: nglogic.bikerental.v1.ListWaitlistRequest
+	(*ListWaitlistResponse)(nil),               // 44: nglogic.bikerental.v1.ListWaitlistResponse
+	(*WithdrawWaitlistEntryRequest)(nil),       // 45: nglogic.bikerental.v1.WithdrawWaitlistEntryRequest
+	(*MaintenanceWindow)(nil),                  // 46: nglogic.bikerental.v1.MaintenanceWindow
+	(*ListMaintenanceRequest)(nil),             // 47: nglogic.bikerental.v1.ListMaintenanceRequest
+	(*ListMaintenanceResponse)(nil),            // 48: nglogic.bikerental.v1.ListMaintenanceResponse
+	(*ScheduleMaintenanceRequest)(nil),         // 49: nglogic.bikerental.v1.ScheduleMaintenanceRequest
+	(*ScheduleMaintenanceResponse)(nil),        // 50: nglogic.bikerental.v1.ScheduleMaintenanceResponse
+	(*CancelMaintenanceRequest)(nil),           // 51: nglogic.bikerental.v1.CancelMaintenanceRequest
+	(*GetInvoiceRequest)(nil),                  // 52: nglogic.bikerental.v1.GetInvoiceRequest
+	(*Invoice)(nil),                            // 53: nglogic.bikerental.v1.Invoice
+	(*ListStationsResponse)(nil),               // 54: nglogic.bikerental.v1.ListStationsResponse
+	(*GetStationRequest)(nil),                  // 55: nglogic.bikerental.v1.GetStationRequest
+	(*CreateStationRequest)(nil),               // 56: nglogic.bikerental.v1.CreateStationRequest
+	(*UpdateStationRequest)(nil),               // 57: nglogic.bikerental.v1.UpdateStationRequest
+	(*DeleteStationRequest)(nil),               // 58: nglogic.bikerental.v1.DeleteStationRequest
+	(*ListCustomersRequest)(nil),               // 59: nglogic.bikerental.v1.ListCustomersRequest
+	(*ListCustomersResponse)(nil),              // 60: nglogic.bikerental.v1.ListCustomersResponse
+	(*GetCustomerRequest)(nil),                 // 61: nglogic.bikerental.v1.GetCustomerRequest
+	(*CreateCustomerRequest)(nil),              // 62: nglogic.bikerental.v1.CreateCustomerRequest
+	(*UpdateCustomerRequest)(nil),              // 63: nglogic.bikerental.v1.UpdateCustomerRequest
+	(*DeleteCustomerRequest)(nil),              // 64: nglogic.bikerental.v1.DeleteCustomerRequest
+	(*Discount)(nil),                           // 65: nglogic.bikerental.v1.Discount
+	(*CheckDiscountRequest)(nil),               // 66: nglogic.bikerental.v1.CheckDiscountRequest
+	(*CheckDiscountResponse)(nil),              // 67: nglogic.bikerental.v1.CheckDiscountResponse
+	(*PromoCode)(nil),                          // 68: nglogic.bikerental.v1.PromoCode
+	(*ListPromoCodesResponse)(nil),             // 69: nglogic.bikerental.v1.ListPromoCodesResponse
+	(*CreatePromoCodeRequest)(nil),             // 70: nglogic.bikerental.v1.CreatePromoCodeRequest
+	(*DisablePromoCodeRequest)(nil),            // 71: nglogic.bikerental.v1.DisablePromoCodeRequest
+	(*timestamp.Timestamp)(nil),                // 72: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                        // 73: google.protobuf.Empty
+}
+var file_nglogic_bikerental_v1_service_proto_depIdxs = []int32{
+	5,   // 0: nglogic.bikerental.v1.Bike.data:type_name -> nglogic.bikerental.v1.BikeData
+	0,   // 1: nglogic.bikerental.v1.BikeData.status:type_name -> nglogic.bikerental.v1.BikeStatus
+	7,   // 2: nglogic.bikerental.v1.Station.data:type_name -> nglogic.bikerental.v1.StationData
+	11,  // 3: nglogic.bikerental.v1.StationData.location:type_name -> nglogic.bikerental.v1.Location
+	9,   // 4: nglogic.bikerental.v1.Customer.data:type_name -> nglogic.bikerental.v1.CustomerData
+	1,   // 5: nglogic.bikerental.v1.CustomerData.type:type_name -> nglogic.bikerental.v1.CustomerType
+	2,   // 6: nglogic.bikerental.v1.Reservation.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	8,   // 7: nglogic.bikerental.v1.Reservation.customer:type_name -> nglogic.bikerental.v1.Customer
+	4,   // 8: nglogic.bikerental.v1.Reservation.bike:type_name -> nglogic.bikerental.v1.Bike
+	72,  // 9: nglogic.bikerental.v1.Reservation.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 10: nglogic.bikerental.v1.Reservation.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 11: nglogic.bikerental.v1.Reservation.picked_up_at:type_name -> google.protobuf.Timestamp
+	72,  // 12: nglogic.bikerental.v1.Reservation.returned_at:type_name -> google.protobuf.Timestamp
+	72,  // 13: nglogic.bikerental.v1.Reservation.hold_expires_at:type_name -> google.protobuf.Timestamp
+	72,  // 14: nglogic.bikerental.v1.Reservation.canceled_at:type_name -> google.protobuf.Timestamp
+	4,   // 15: nglogic.bikerental.v1.ListBikesResponse.bikes:type_name -> nglogic.bikerental.v1.Bike
+	5,   // 16: nglogic.bikerental.v1.CreateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
+	5,   // 17: nglogic.bikerental.v1.UpdateBikeRequest.data:type_name -> nglogic.bikerental.v1.BikeData
+	72,  // 18: nglogic.bikerental.v1.GetBikeAvailabilityRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 19: nglogic.bikerental.v1.GetBikeAvailabilityRequest.end_time:type_name -> google.protobuf.Timestamp
+	11,  // 20: nglogic.bikerental.v1.GetBikeAvailabilityRequest.location:type_name -> nglogic.bikerental.v1.Location
+	8,   // 21: nglogic.bikerental.v1.CreateReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
+	11,  // 22: nglogic.bikerental.v1.CreateReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 23: nglogic.bikerental.v1.CreateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 24: nglogic.bikerental.v1.CreateReservationRequest.end_time:type_name -> google.protobuf.Timestamp
+	10,  // 25: nglogic.bikerental.v1.CreateReservationResponse.reservation:type_name -> nglogic.bikerental.v1.Reservation
+	2,   // 26: nglogic.bikerental.v1.CreateReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	11,  // 27: nglogic.bikerental.v1.UpdateReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 28: nglogic.bikerental.v1.UpdateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 29: nglogic.bikerental.v1.UpdateReservationRequest.end_time:type_name -> google.protobuf.Timestamp
+	10,  // 30: nglogic.bikerental.v1.UpdateReservationResponse.reservation:type_name -> nglogic.bikerental.v1.Reservation
+	2,   // 31: nglogic.bikerental.v1.UpdateReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	8,   // 32: nglogic.bikerental.v1.CreateGroupReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
+	11,  // 33: nglogic.bikerental.v1.CreateGroupReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 34: nglogic.bikerental.v1.CreateGroupReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 35: nglogic.bikerental.v1.CreateGroupReservationRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,   // 36: nglogic.bikerental.v1.CreateGroupReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	10,  // 37: nglogic.bikerental.v1.CreateGroupReservationResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
+	65,  // 38: nglogic.bikerental.v1.CreateGroupReservationResponse.discount:type_name -> nglogic.bikerental.v1.Discount
+	8,   // 39: nglogic.bikerental.v1.CreateRecurringReservationRequest.customer:type_name -> nglogic.bikerental.v1.Customer
+	11,  // 40: nglogic.bikerental.v1.CreateRecurringReservationRequest.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 41: nglogic.bikerental.v1.CreateRecurringReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 42: nglogic.bikerental.v1.CreateRecurringReservationRequest.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 43: nglogic.bikerental.v1.CreateRecurringReservationRequest.until:type_name -> google.protobuf.Timestamp
+	72,  // 44: nglogic.bikerental.v1.Occurrence.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 45: nglogic.bikerental.v1.Occurrence.end_time:type_name -> google.protobuf.Timestamp
+	2,   // 46: nglogic.bikerental.v1.CreateRecurringReservationResponse.status:type_name -> nglogic.bikerental.v1.ReservationStatus
+	10,  // 47: nglogic.bikerental.v1.CreateRecurringReservationResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
+	27,  // 48: nglogic.bikerental.v1.CreateRecurringReservationResponse.conflicts:type_name -> nglogic.bikerental.v1.Occurrence
+	72,  // 49: nglogic.bikerental.v1.SearchAvailableBikesRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 50: nglogic.bikerental.v1.SearchAvailableBikesRequest.end_time:type_name -> google.protobuf.Timestamp
+	11,  // 51: nglogic.bikerental.v1.SearchAvailableBikesRequest.location:type_name -> nglogic.bikerental.v1.Location
+	33,  // 52: nglogic.bikerental.v1.SearchAvailableBikesResponse.bikes:type_name -> nglogic.bikerental.v1.AvailableBike
+	4,   // 53: nglogic.bikerental.v1.AvailableBike.bike:type_name -> nglogic.bikerental.v1.Bike
+	72,  // 54: nglogic.bikerental.v1.ListReservationsRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 55: nglogic.bikerental.v1.ListReservationsRequest.end_time:type_name -> google.protobuf.Timestamp
+	10,  // 56: nglogic.bikerental.v1.ListReservationsResponse.reservations:type_name -> nglogic.bikerental.v1.Reservation
+	72,  // 57: nglogic.bikerental.v1.CancelReservationResponse.canceled_at:type_name -> google.protobuf.Timestamp
+	3,   // 58: nglogic.bikerental.v1.WaitlistEntry.status:type_name -> nglogic.bikerental.v1.WaitlistStatus
+	8,   // 59: nglogic.bikerental.v1.WaitlistEntry.customer:type_name -> nglogic.bikerental.v1.Customer
+	11,  // 60: nglogic.bikerental.v1.WaitlistEntry.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 61: nglogic.bikerental.v1.WaitlistEntry.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 62: nglogic.bikerental.v1.WaitlistEntry.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 63: nglogic.bikerental.v1.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	42,  // 64: nglogic.bikerental.v1.ListWaitlistResponse.entries:type_name -> nglogic.bikerental.v1.WaitlistEntry
+	72,  // 65: nglogic.bikerental.v1.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 66: nglogic.bikerental.v1.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 67: nglogic.bikerental.v1.ListMaintenanceRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 68: nglogic.bikerental.v1.ListMaintenanceRequest.end_time:type_name -> google.protobuf.Timestamp
+	46,  // 69: nglogic.bikerental.v1.ListMaintenanceResponse.windows:type_name -> nglogic.bikerental.v1.MaintenanceWindow
+	72,  // 70: nglogic.bikerental.v1.ScheduleMaintenanceRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 71: nglogic.bikerental.v1.ScheduleMaintenanceRequest.end_time:type_name -> google.protobuf.Timestamp
+	46,  // 72: nglogic.bikerental.v1.ScheduleMaintenanceResponse.window:type_name -> nglogic.bikerental.v1.MaintenanceWindow
+	10,  // 73: nglogic.bikerental.v1.ScheduleMaintenanceResponse.conflicts:type_name -> nglogic.bikerental.v1.Reservation
+	72,  // 74: nglogic.bikerental.v1.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 75: nglogic.bikerental.v1.ListStationsResponse.stations:type_name -> nglogic.bikerental.v1.Station
+	7,   // 76: nglogic.bikerental.v1.CreateStationRequest.data:type_name -> nglogic.bikerental.v1.StationData
+	7,   // 77: nglogic.bikerental.v1.UpdateStationRequest.data:type_name -> nglogic.bikerental.v1.StationData
+	1,   // 78: nglogic.bikerental.v1.ListCustomersRequest.type:type_name -> nglogic.bikerental.v1.CustomerType
+	8,   // 79: nglogic.bikerental.v1.ListCustomersResponse.customers:type_name -> nglogic.bikerental.v1.Customer
+	9,   // 80: nglogic.bikerental.v1.CreateCustomerRequest.data:type_name -> nglogic.bikerental.v1.CustomerData
+	9,   // 81: nglogic.bikerental.v1.UpdateCustomerRequest.data:type_name -> nglogic.bikerental.v1.CustomerData
+	8,   // 82: nglogic.bikerental.v1.CheckDiscountRequest.customer:type_name -> nglogic.bikerental.v1.Customer
+	11,  // 83: nglogic.bikerental.v1.CheckDiscountRequest.location:type_name -> nglogic.bikerental.v1.Location
+	72,  // 84: nglogic.bikerental.v1.CheckDiscountRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 85: nglogic.bikerental.v1.CheckDiscountRequest.end_time:type_name -> google.protobuf.Timestamp
+	65,  // 86: nglogic.bikerental.v1.CheckDiscountResponse.discount:type_name -> nglogic.bikerental.v1.Discount
+	65,  // 87: nglogic.bikerental.v1.CheckDiscountResponse.candidates:type_name -> nglogic.bikerental.v1.Discount
+	72,  // 88: nglogic.bikerental.v1.PromoCode.valid_from:type_name -> google.protobuf.Timestamp
+	72,  // 89: nglogic.bikerental.v1.PromoCode.valid_to:type_name -> google.protobuf.Timestamp
+	68,  // 90: nglogic.bikerental.v1.ListPromoCodesResponse.promo_codes:type_name -> nglogic.bikerental.v1.PromoCode
+	68,  // 91: nglogic.bikerental.v1.CreatePromoCodeRequest.promo_code:type_name -> nglogic.bikerental.v1.PromoCode
+	12,  // 92: nglogic.bikerental.v1.BikeRentalService.ListBikes:input_type -> nglogic.bikerental.v1.ListBikesRequest
+	14,  // 93: nglogic.bikerental.v1.BikeRentalService.GetBike:input_type -> nglogic.bikerental.v1.GetBikeRequest
+	15,  // 94: nglogic.bikerental.v1.BikeRentalService.CreateBike:input_type -> nglogic.bikerental.v1.CreateBikeRequest
+	17,  // 95: nglogic.bikerental.v1.BikeRentalService.DeleteBike:input_type -> nglogic.bikerental.v1.DeleteBikeRequest
+	16,  // 96: nglogic.bikerental.v1.BikeRentalService.UpdateBike:input_type -> nglogic.bikerental.v1.UpdateBikeRequest
+	18,  // 97: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:input_type -> nglogic.bikerental.v1.GetBikeAvailabilityRequest
+	31,  // 98: nglogic.bikerental.v1.BikeRentalService.SearchAvailableBikes:input_type -> nglogic.bikerental.v1.SearchAvailableBikesRequest
+	34,  // 99: nglogic.bikerental.v1.BikeRentalService.ListReservations:input_type -> nglogic.bikerental.v1.ListReservationsRequest
+	20,  // 100: nglogic.bikerental.v1.BikeRentalService.CreateReservation:input_type -> nglogic.bikerental.v1.CreateReservationRequest
+	22,  // 101: nglogic.bikerental.v1.BikeRentalService.UpdateReservation:input_type -> nglogic.bikerental.v1.UpdateReservationRequest
+	20,  // 102: nglogic.bikerental.v1.BikeRentalService.HoldBike:input_type -> nglogic.bikerental.v1.CreateReservationRequest
+	38,  // 103: nglogic.bikerental.v1.BikeRentalService.ConfirmReservation:input_type -> nglogic.bikerental.v1.ConfirmReservationRequest
+	24,  // 104: nglogic.bikerental.v1.BikeRentalService.CreateGroupReservation:input_type -> nglogic.bikerental.v1.CreateGroupReservationRequest
+	26,  // 105: nglogic.bikerental.v1.BikeRentalService.CreateRecurringReservation:input_type -> nglogic.bikerental.v1.CreateRecurringReservationRequest
+	29,  // 106: nglogic.bikerental.v1.BikeRentalService.CancelReservationSeries:input_type -> nglogic.bikerental.v1.CancelReservationSeriesRequest
+	36,  // 107: nglogic.bikerental.v1.BikeRentalService.CancelReservation:input_type -> nglogic.bikerental.v1.CancelReservationRequest
+	39,  // 108: nglogic.bikerental.v1.BikeRentalService.StartRental:input_type -> nglogic.bikerental.v1.StartRentalRequest
+	40,  // 109: nglogic.bikerental.v1.BikeRentalService.CompleteRental:input_type -> nglogic.bikerental.v1.CompleteRentalRequest
+	41,  // 110: nglogic.bikerental.v1.BikeRentalService.MarkNoShow:input_type -> nglogic.bikerental.v1.MarkNoShowRequest
+	52,  // 111: nglogic.bikerental.v1.BikeRentalService.GetInvoice:input_type -> nglogic.bikerental.v1.GetInvoiceRequest
+	20,  // 112: nglogic.bikerental.v1.BikeRentalService.JoinWaitlist:input_type -> nglogic.bikerental.v1.CreateReservationRequest
+	43,  // 113: nglogic.bikerental.v1.BikeRentalService.ListWaitlist:input_type -> nglogic.bikerental.v1.ListWaitlistRequest
+	45,  // 114: nglogic.bikerental.v1.BikeRentalService.WithdrawWaitlistEntry:input_type -> nglogic.bikerental.v1.WithdrawWaitlistEntryRequest
+	47,  // 115: nglogic.bikerental.v1.BikeRentalService.ListMaintenance:input_type -> nglogic.bikerental.v1.ListMaintenanceRequest
+	49,  // 116: nglogic.bikerental.v1.BikeRentalService.ScheduleMaintenance:input_type -> nglogic.bikerental.v1.ScheduleMaintenanceRequest
+	51,  // 117: nglogic.bikerental.v1.BikeRentalService.CancelMaintenance:input_type -> nglogic.bikerental.v1.CancelMaintenanceRequest
+	66,  // 118: nglogic.bikerental.v1.BikeRentalService.CheckDiscount:input_type -> nglogic.bikerental.v1.CheckDiscountRequest
+	73,  // 119: nglogic.bikerental.v1.BikeRentalService.ListStations:input_type -> google.protobuf.Empty
+	55,  // 120: nglogic.bikerental.v1.BikeRentalService.GetStation:input_type -> nglogic.bikerental.v1.GetStationRequest
+	56,  // 121: nglogic.bikerental.v1.BikeRentalService.CreateStation:input_type -> nglogic.bikerental.v1.CreateStationRequest
+	57,  // 122: nglogic.bikerental.v1.BikeRentalService.UpdateStation:input_type -> nglogic.bikerental.v1.UpdateStationRequest
+	58,  // 123: nglogic.bikerental.v1.BikeRentalService.DeleteStation:input_type -> nglogic.bikerental.v1.DeleteStationRequest
+	59,  // 124: nglogic.bikerental.v1.BikeRentalService.ListCustomers:input_type -> nglogic.bikerental.v1.ListCustomersRequest
+	61,  // 125: nglogic.bikerental.v1.BikeRentalService.GetCustomer:input_type -> nglogic.bikerental.v1.GetCustomerRequest
+	62,  // 126: nglogic.bikerental.v1.BikeRentalService.CreateCustomer:input_type -> nglogic.bikerental.v1.CreateCustomerRequest
+	63,  // 127: nglogic.bikerental.v1.BikeRentalService.UpdateCustomer:input_type -> nglogic.bikerental.v1.UpdateCustomerRequest
+	64,  // 128: nglogic.bikerental.v1.BikeRentalService.DeleteCustomer:input_type -> nglogic.bikerental.v1.DeleteCustomerRequest
+	73,  // 129: nglogic.bikerental.v1.BikeRentalService.ListPromoCodes:input_type -> google.protobuf.Empty
+	70,  // 130: nglogic.bikerental.v1.BikeRentalService.CreatePromoCode:input_type -> nglogic.bikerental.v1.CreatePromoCodeRequest
+	71,  // 131: nglogic.bikerental.v1.BikeRentalService.DisablePromoCode:input_type -> nglogic.bikerental.v1.DisablePromoCodeRequest
+	13,  // 132: nglogic.bikerental.v1.BikeRentalService.ListBikes:output_type -> nglogic.bikerental.v1.ListBikesResponse
+	4,   // 133: nglogic.bikerental.v1.BikeRentalService.GetBike:output_type -> nglogic.bikerental.v1.Bike
+	4,   // 134: nglogic.bikerental.v1.BikeRentalService.CreateBike:output_type -> nglogic.bikerental.v1.Bike
+	73,  // 135: nglogic.bikerental.v1.BikeRentalService.DeleteBike:output_type -> google.protobuf.Empty
+	73,  // 136: nglogic.bikerental.v1.BikeRentalService.UpdateBike:output_type -> google.protobuf.Empty
+	19,  // 137: nglogic.bikerental.v1.BikeRentalService.GetBikeAvailability:output_type -> nglogic.bikerental.v1.GetBikeAvailabilityResponse
+	32,  // 138: nglogic.bikerental.v1.BikeRentalService.SearchAvailableBikes:output_type -> nglogic.bikerental.v1.SearchAvailableBikesResponse
+	35,  // 139: nglogic.bikerental.v1.BikeRentalService.ListReservations:output_type -> nglogic.bikerental.v1.ListReservationsResponse
+	21,  // 140: nglogic.bikerental.v1.BikeRentalService.CreateReservation:output_type -> nglogic.bikerental.v1.CreateReservationResponse
+	23,  // 141: nglogic.bikerental.v1.BikeRentalService.UpdateReservation:output_type -> nglogic.bikerental.v1.UpdateReservationResponse
+	21,  // 142: nglogic.bikerental.v1.BikeRentalService.HoldBike:output_type -> nglogic.bikerental.v1.CreateReservationResponse
+	10,  // 143: nglogic.bikerental.v1.BikeRentalService.ConfirmReservation:output_type -> nglogic.bikerental.v1.Reservation
+	25,  // 144: nglogic.bikerental.v1.BikeRentalService.CreateGroupReservation:output_type -> nglogic.bikerental.v1.CreateGroupReservationResponse
+	28,  // 145: nglogic.bikerental.v1.BikeRentalService.CreateRecurringReservation:output_type -> nglogic.bikerental.v1.CreateRecurringReservationResponse
+	30,  // 146: nglogic.bikerental.v1.BikeRentalService.CancelReservationSeries:output_type -> nglogic.bikerental.v1.CancelReservationSeriesResponse
+	37,  // 147: nglogic.bikerental.v1.BikeRentalService.CancelReservation:output_type -> nglogic.bikerental.v1.CancelReservationResponse
+	10,  // 148: nglogic.bikerental.v1.BikeRentalService.StartRental:output_type -> nglogic.bikerental.v1.Reservation
+	10,  // 149: nglogic.bikerental.v1.BikeRentalService.CompleteRental:output_type -> nglogic.bikerental.v1.Reservation
+	10,  // 150: nglogic.bikerental.v1.BikeRentalService.MarkNoShow:output_type -> nglogic.bikerental.v1.Reservation
+	53,  // 151: nglogic.bikerental.v1.BikeRentalService.GetInvoice:output_type -> nglogic.bikerental.v1.Invoice
+	42,  // 152: nglogic.bikerental.v1.BikeRentalService.JoinWaitlist:output_type -> nglogic.bikerental.v1.WaitlistEntry
+	44,  // 153: nglogic.bikerental.v1.BikeRentalService.ListWaitlist:output_type -> nglogic.bikerental.v1.ListWaitlistResponse
+	73,  // 154: nglogic.bikerental.v1.BikeRentalService.WithdrawWaitlistEntry:output_type -> google.protobuf.Empty
+	48,  // 155: nglogic.bikerental.v1.BikeRentalService.ListMaintenance:output_type -> nglogic.bikerental.v1.ListMaintenanceResponse
+	50,  // 156: nglogic.bikerental.v1.BikeRentalService.ScheduleMaintenance:output_type -> nglogic.bikerental.v1.ScheduleMaintenanceResponse
+	73,  // 157: nglogic.bikerental.v1.BikeRentalService.CancelMaintenance:output_type -> google.protobuf.Empty
+	67,  // 158: nglogic.bikerental.v1.BikeRentalService.CheckDiscount:output_type -> nglogic.bikerental.v1.CheckDiscountResponse
+	54,  // 159: nglogic.bikerental.v1.BikeRentalService.ListStations:output_type -> nglogic.bikerental.v1.ListStationsResponse
+	6,   // 160: nglogic.bikerental.v1.BikeRentalService.GetStation:output_type -> nglogic.bikerental.v1.Station
+	6,   // 161: nglogic.bikerental.v1.BikeRentalService.CreateStation:output_type -> nglogic.bikerental.v1.Station
+	73,  // 162: nglogic.bikerental.v1.BikeRentalService.UpdateStation:output_type -> google.protobuf.Empty
+	73,  // 163: nglogic.bikerental.v1.BikeRentalService.DeleteStation:output_type -> google.protobuf.Empty
+	60,  // 164: nglogic.bikerental.v1.BikeRentalService.ListCustomers:output_type -> nglogic.bikerental.v1.ListCustomersResponse
+	8,   // 165: nglogic.bikerental.v1.BikeRentalService.GetCustomer:output_type -> nglogic.bikerental.v1.Customer
+	8,   // 166: nglogic.bikerental.v1.BikeRentalService.CreateCustomer:output_type -> nglogic.bikerental.v1.Customer
+	73,  // 167: nglogic.bikerental.v1.BikeRentalService.UpdateCustomer:output_type -> google.protobuf.Empty
+	73,  // 168: nglogic.bikerental.v1.BikeRentalService.DeleteCustomer:output_type -> google.protobuf.Empty
+	69,  // 169: nglogic.bikerental.v1.BikeRentalService.ListPromoCodes:output_type -> nglogic.bikerental.v1.ListPromoCodesResponse
+	68,  // 170: nglogic.bikerental.v1.BikeRentalService.CreatePromoCode:output_type -> nglogic.bikerental.v1.PromoCode
+	73,  // 171: nglogic.bikerental.v1.BikeRentalService.DisablePromoCode:output_type -> google.protobuf.Empty
+	132, // [132:172] is the sub-list for method output_type
+	92,  // [92:132] is the sub-list for method input_type
+	92,  // [92:92] is the sub-list for extension type_name
+	92,  // [92:92] is the sub-list for extension extendee
+	0,   // [0:92] is the sub-list for field type_name
+}
+
+func init() { file_nglogic_bikerental_v1_service_proto_init() }
+func file_nglogic_bikerental_v1_service_proto_init() {
+	if File_nglogic_bikerental_v1_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nglogic_bikerental_v1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bike); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BikeData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Customer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomerData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBikesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBikesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBikeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBikeAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Occurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAvailableBikesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAvailableBikesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableBike); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmReservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRentalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteRentalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkNoShowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWaitlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawWaitlistEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCustomersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCustomersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Discount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDiscountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDiscountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPromoCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nglogic_bikerental_v1_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisablePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nglogic_bikerental_v1_service_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   68,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nglogic_bikerental_v1_service_proto_goTypes,
+		DependencyIndexes: file_nglogic_bikerental_v1_service_proto_depIdxs,
+		EnumInfos:         file_nglogic_bikerental_v1_service_proto_enumTypes,
+		MessageInfos:      file_nglogic_bikerental_v1_service_proto_msgTypes,
+	}.Build()
+	File_nglogic_bikerental_v1_service_proto = out.File
+	file_nglogic_bikerental_v1_service_proto_rawDesc = nil
+	file_nglogic_bikerental_v1_service_proto_goTypes = nil
+	file_nglogic_bikerental_v1_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BikeRentalServiceClient is the client API for BikeRentalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BikeRentalServiceClient interface {
+	// List bikes.
+	//
+	// Returns a page of bikes sorted by model name.
+	ListBikes(ctx context.Context, in *ListBikesRequest, opts ...grpc.CallOption) (*ListBikesResponse, error)
+	// Return bike by id.
+	GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error)
+	// Create new bike.
+	//
+	// Returns created object with new id.
+	CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error)
+	// Delete a bike by id.
+	DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Update a bike.
+	UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Check if bike is available.
+	//
+	// Bikes out of service are never available.
+	// If location is set, bike has to be at a station near that location.
+	GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error)
+	// Search available bikes.
+	//
+	// Returns bikes that can be rented in given time range, sorted by distance and price.
+	// If location is set, only bikes at stations within radius are returned.
+	SearchAvailableBikes(ctx context.Context, in *SearchAvailableBikesRequest, opts ...grpc.CallOption) (*SearchAvailableBikesResponse, error)
+	// List reservations.
+	//
+	// Returns a page of reservations for a bike, sorted by start time.
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// Create reservation.
+	//
+	// Returns created object with new id.
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	// Update reservation.
+	//
+	// Changes time range or bike of approved or pending reservation in one step, so the slot is never lost.
+	// Reservation value and discount are recalculated, and the price difference is returned.
+	// If the change is not possible, rejected status is returned and reservation is left unchanged.
+	UpdateReservation(ctx context.Context, in *UpdateReservationRequest, opts ...grpc.CallOption) (*UpdateReservationResponse, error)
+	// Hold bike.
+	//
+	// Creates pending reservation, which blocks the bike until hold expires.
+	// Reservation has to be confirmed before hold expiry time, otherwise it expires.
+	HoldBike(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	// Confirm reservation.
+	//
+	// Changes pending reservation to approved, if its hold didn't expire.
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Create group reservation.
+	//
+	// Reserves multiple bikes for one customer and time range, all of them or none.
+	// Discount is calculated for combined value of all bikes.
+	CreateGroupReservation(ctx context.Context, in *CreateGroupReservationRequest, opts ...grpc.CallOption) (*CreateGroupReservationResponse, error)
+	// Create recurring reservation.
+	//
+	// Expands recurrence rule into occurrences and reserves the bike for all of them, or for none.
+	// If the bike is not available for some occurrences, they are returned as conflicts.
+	CreateRecurringReservation(ctx context.Context, in *CreateRecurringReservationRequest, opts ...grpc.CallOption) (*CreateRecurringReservationResponse, error)
+	// Cancel reservation series.
+	//
+	// Cancels all approved reservations created by one recurring reservation request.
+	CancelReservationSeries(ctx context.Context, in *CancelReservationSeriesRequest, opts ...grpc.CallOption) (*CancelReservationSeriesResponse, error)
+	// Cancel reservation.
+	//
+	// Refund and cancellation fee are calculated with cancellation policy of customer type.
+	// Reservations that already ended can't be canceled.
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
+	// Start rental.
+	//
+	// Marks reservation as active when customer picks up the bike.
+	// Bike can be picked up only between reservation start and end time.
+	StartRental(ctx context.Context, in *StartRentalRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Complete rental.
+	//
+	// Marks active reservation as completed when customer returns the bike.
+	CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Mark reservation as no-show.
+	//
+	// Used when customer didn't pick up the bike.
+	MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Get invoice.
+	//
+	// Returns final invoice of a completed rental.
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	// Join waitlist.
+	//
+	// Enqueues reservation request for a bike that is not available in requested time range.
+	// When overlapping reservation is canceled, the oldest fitting entry is converted into approved reservation.
+	JoinWaitlist(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	// List waitlist.
+	//
+	// Returns all waitlist entries for a bike, sorted by creation time.
+	ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error)
+	// Withdraw waitlist entry.
+	WithdrawWaitlistEntry(ctx context.Context, in *WithdrawWaitlistEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List maintenance windows.
+	//
+	// Returns maintenance windows of a bike overlapping with given time range, sorted by start time.
+	ListMaintenance(ctx context.Context, in *ListMaintenanceRequest, opts ...grpc.CallOption) (*ListMaintenanceResponse, error)
+	// Schedule maintenance.
+	//
+	// Bike can't be rented during maintenance window.
+	// If the window overlaps with approved, active or held reservations, it's not scheduled,
+	// and conflicting reservations are returned instead.
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error)
+	// Cancel maintenance.
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Check possible discount.
+	//
+	// Returns discount that would be applied to a reservation, without reserving the bike.
+	// Bike availability in requested time range doesn't matter.
+	CheckDiscount(ctx context.Context, in *CheckDiscountRequest, opts ...grpc.CallOption) (*CheckDiscountResponse, error)
+	// List all rental stations.
+	ListStations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListStationsResponse, error)
+	// Return station by id.
+	GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*Station, error)
+	// Create new station.
+	//
+	// Returns created object with new id.
+	CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*Station, error)
+	// Update a station.
+	UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete a station by id.
+	//
+	// Stations with assigned bikes can't be deleted.
+	DeleteStation(ctx context.Context, in *DeleteStationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List customers.
+	//
+	// Returns a page of customers, optionally filtered by email and type.
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
+	// Return customer by id.
+	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*Customer, error)
+	// Create new customer.
+	//
+	// Returns created object with new id.
+	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*Customer, error)
+	// Update a customer.
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete a customer by id.
+	//
+	// Customers with reservations can't be deleted.
+	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List all promo codes.
+	ListPromoCodes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	// Create new promo code.
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	// Disable promo code.
+	//
+	// Disabled code can't be redeemed anymore.
+	DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type bikeRentalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBikeRentalServiceClient(cc grpc.ClientConnInterface) BikeRentalServiceClient {
+	return &bikeRentalServiceClient{cc}
+}
+
+func (c *bikeRentalServiceClient) ListBikes(ctx context.Context, in *ListBikesRequest, opts ...grpc.CallOption) (*ListBikesResponse, error) {
+	out := new(ListBikesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListBikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
+	out := new(Bike)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
+	out := new(Bike)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error) {
+	out := new(GetBikeAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) SearchAvailableBikes(ctx context.Context, in *SearchAvailableBikesRequest, opts ...grpc.CallOption) (*SearchAvailableBikesResponse, error) {
+	out := new(SearchAvailableBikesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/SearchAvailableBikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateReservation(ctx context.Context, in *UpdateReservationRequest, opts ...grpc.CallOption) (*UpdateReservationResponse, error) {
+	out := new(UpdateReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) HoldBike(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/HoldBike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ConfirmReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateGroupReservation(ctx context.Context, in *CreateGroupReservationRequest, opts ...grpc.CallOption) (*CreateGroupReservationResponse, error) {
+	out := new(CreateGroupReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateGroupReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateRecurringReservation(ctx context.Context, in *CreateRecurringReservationRequest, opts ...grpc.CallOption) (*CreateRecurringReservationResponse, error) {
+	out := new(CreateRecurringReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateRecurringReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CancelReservationSeries(ctx context.Context, in *CancelReservationSeriesRequest, opts ...grpc.CallOption) (*CancelReservationSeriesResponse, error) {
+	out := new(CancelReservationSeriesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelReservationSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error) {
+	out := new(CancelReservationResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) StartRental(ctx context.Context, in *StartRentalRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/StartRental", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CompleteRental(ctx context.Context, in *CompleteRentalRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CompleteRental", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/MarkNoShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) JoinWaitlist(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error) {
+	out := new(ListWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) WithdrawWaitlistEntry(ctx context.Context, in *WithdrawWaitlistEntryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/WithdrawWaitlistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListMaintenance(ctx context.Context, in *ListMaintenanceRequest, opts ...grpc.CallOption) (*ListMaintenanceResponse, error) {
+	out := new(ListMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceResponse, error) {
+	out := new(ScheduleMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ScheduleMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CheckDiscount(ctx context.Context, in *CheckDiscountRequest, opts ...grpc.CallOption) (*CheckDiscountResponse, error) {
+	out := new(CheckDiscountResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CheckDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListStations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListStationsResponse, error) {
+	out := new(ListStationsResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListStations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*Station, error) {
+	out := new(Station)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*Station, error) {
+	out := new(Station)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DeleteStation(ctx context.Context, in *DeleteStationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error) {
+	out := new(ListCustomersResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListCustomers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*Customer, error) {
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*Customer, error) {
+	out := new(Customer)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) ListPromoCodes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListPromoCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bikeRentalServiceClient) DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DisablePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BikeRentalServiceServer is the server API for BikeRentalService service.
+type BikeRentalServiceServer interface {
+	// List bikes.
+	//
+	// Returns a page of bikes sorted by model name.
+	ListBikes(context.Context, *ListBikesRequest) (*ListBikesResponse, error)
+	// Return bike by id.
+	GetBike(context.Context, *GetBikeRequest) (*Bike, error)
+	// Create new bike.
+	//
+	// Returns created object with new id.
+	CreateBike(context.Context, *CreateBikeRequest) (*Bike, error)
+	// Delete a bike by id.
+	DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error)
+	// Update a bike.
+	UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error)
+	// Check if bike is available.
+	//
+	// Bikes out of service are never available.
+	// If location is set, bike has to be at a station near that location.
+	GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error)
+	// Search available bikes.
+	//
+	// Returns bikes that can be rented in given time range, sorted by distance and price.
+	// If location is set, only bikes at stations within radius are returned.
+	SearchAvailableBikes(context.Context, *SearchAvailableBikesRequest) (*SearchAvailableBikesResponse, error)
+	// List reservations.
+	//
+	// Returns a page of reservations for a bike, sorted by start time.
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// Create reservation.
+	//
+	// Returns created object with new id.
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	// Update reservation.
+	//
+	// Changes time range or bike of approved or pending reservation in one step, so the slot is never lost.
+	// Reservation value and discount are recalculated, and the price difference is returned.
+	// If the change is not possible, rejected status is returned and reservation is left unchanged.
+	UpdateReservation(context.Context, *UpdateReservationRequest) (*UpdateReservationResponse, error)
+	// Hold bike.
+	//
+	// Creates pending reservation, which blocks the bike until hold expires.
+	// Reservation has to be confirmed before hold expiry time, otherwise it expires.
+	HoldBike(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	// Confirm reservation.
+	//
+	// Changes pending reservation to approved, if its hold didn't expire.
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*Reservation, error)
+	// Create group reservation.
+	//
+	// Reserves multiple bikes for one customer and time range, all of them or none.
+	// Discount is calculated for combined value of all bikes.
+	CreateGroupReservation(context.Context, *CreateGroupReservationRequest) (*CreateGroupReservationResponse, error)
+	// Create recurring reservation.
+	//
+	// Expands recurrence rule into occurrences and reserves the bike for all of them, or for none.
+	// If the bike is not available for some occurrences, they are returned as conflicts.
+	CreateRecurringReservation(context.Context, *CreateRecurringReservationRequest) (*CreateRecurringReservationResponse, error)
+	// Cancel reservation series.
+	//
+	// Cancels all approved reservations created by one recurring reservation request.
+	CancelReservationSeries(context.Context, *CancelReservationSeriesRequest) (*CancelReservationSeriesResponse, error)
+	// Cancel reservation.
+	//
+	// Refund and cancellation fee are calculated with cancellation policy of customer type.
+	// Reservations that already ended can't be canceled.
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+	// Start rental.
+	//
+	// Marks reservation as active when customer picks up the bike.
+	// Bike can be picked up only between reservation start and end time.
+	StartRental(context.Context, *StartRentalRequest) (*Reservation, error)
+	// Complete rental.
+	//
+	// Marks active reservation as completed when customer returns the bike.
+	CompleteRental(context.Context, *CompleteRentalRequest) (*Reservation, error)
+	// Mark reservation as no-show.
+	//
+	// Used when customer didn't pick up the bike.
+	MarkNoShow(context.Context, *MarkNoShowRequest) (*Reservation, error)
+	// Get invoice.
+	//
+	// Returns final invoice of a completed rental.
+	GetInvoice(context.Context, *GetInvoiceRequest) (*Invoice, error)
+	// Join waitlist.
+	//
+	// Enqueues reservation request for a bike that is not available in requested time range.
+	// When overlapping reservation is canceled, the oldest fitting entry is converted into approved reservation.
+	JoinWaitlist(context.Context, *CreateReservationRequest) (*WaitlistEntry, error)
+	// List waitlist.
+	//
+	// Returns all waitlist entries for a bike, sorted by creation time.
+	ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error)
+	// Withdraw waitlist entry.
+	WithdrawWaitlistEntry(context.Context, *WithdrawWaitlistEntryRequest) (*empty.Empty, error)
+	// List maintenance windows.
+	//
+	// Returns maintenance windows of a bike overlapping with given time range, sorted by start time.
+	ListMaintenance(context.Context, *ListMaintenanceRequest) (*ListMaintenanceResponse, error)
+	// Schedule maintenance.
+	//
+	// Bike can't be rented during maintenance window.
+	// If the window overlaps with approved, active or held reservations, it's not scheduled,
+	// and conflicting reservations are returned instead.
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error)
+	// Cancel maintenance.
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*empty.Empty, error)
+	// Check possible discount.
+	//
+	// Returns discount that would be applied to a reservation, without reserving the bike.
+	// Bike availability in requested time range doesn't matter.
+	CheckDiscount(context.Context, *CheckDiscountRequest) (*CheckDiscountResponse, error)
+	// List all rental stations.
+	ListStations(context.Context, *empty.Empty) (*ListStationsResponse, error)
+	// Return station by id.
+	GetStation(context.Context, *GetStationRequest) (*Station, error)
+	// Create new station.
+	//
+	// Returns created object with new id.
+	CreateStation(context.Context, *CreateStationRequest) (*Station, error)
+	// Update a station.
+	UpdateStation(context.Context, *UpdateStationRequest) (*empty.Empty, error)
+	// Delete a station by id.
+	//
+	// Stations with assigned bikes can't be deleted.
+	DeleteStation(context.Context, *DeleteStationRequest) (*empty.Empty, error)
+	// List customers.
+	//
+	// Returns a page of customers, optionally filtered by email and type.
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	// Return customer by id.
+	GetCustomer(context.Context, *GetCustomerRequest) (*Customer, error)
+	// Create new customer.
+	//
+	// Returns created object with new id.
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*Customer, error)
+	// Update a customer.
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*empty.Empty, error)
+	// Delete a customer by id.
+	//
+	// Customers with reservations can't be deleted.
+	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*empty.Empty, error)
+	// List all promo codes.
+	ListPromoCodes(context.Context, *empty.Empty) (*ListPromoCodesResponse, error)
+	// Create new promo code.
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error)
+	// Disable promo code.
+	//
+	// Disabled code can't be redeemed anymore.
+	DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*empty.Empty, error)
+}
+
+// UnimplementedBikeRentalServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBikeRentalServiceServer struct {
+}
+
+func (*UnimplementedBikeRentalServiceServer) ListBikes(context.Context, *ListBikesRequest) (*ListBikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBikes not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetBike(context.Context, *GetBikeRequest) (*Bike, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateBike(context.Context, *CreateBikeRequest) (*Bike, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBikeAvailability not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) SearchAvailableBikes(context.Context, *SearchAvailableBikesRequest) (*SearchAvailableBikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAvailableBikes not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateReservation(context.Context, *UpdateReservationRequest) (*UpdateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) HoldBike(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldBike not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateGroupReservation(context.Context, *CreateGroupReservationRequest) (*CreateGroupReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateRecurringReservation(context.Context, *CreateRecurringReservationRequest) (*CreateRecurringReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CancelReservationSeries(context.Context, *CancelReservationSeriesRequest) (*CancelReservationSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservationSeries not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) StartRental(context.Context, *StartRentalRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRental not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CompleteRental(context.Context, *CompleteRentalRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRental not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) MarkNoShow(context.Context, *MarkNoShowRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) JoinWaitlist(context.Context, *CreateReservationRequest) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitlist not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) WithdrawWaitlistEntry(context.Context, *WithdrawWaitlistEntryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawWaitlistEntry not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListMaintenance(context.Context, *ListMaintenanceRequest) (*ListMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenance not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*ScheduleMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenance not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CheckDiscount(context.Context, *CheckDiscountRequest) (*CheckDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDiscount not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListStations(context.Context, *empty.Empty) (*ListStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStations not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetStation(context.Context, *GetStationRequest) (*Station, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateStation(context.Context, *CreateStationRequest) (*Station, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateStation(context.Context, *UpdateStationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DeleteStation(context.Context, *DeleteStationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStation not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomers not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) GetCustomer(context.Context, *GetCustomerRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomer not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*Customer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomer not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) ListPromoCodes(context.Context, *empty.Empty) (*ListPromoCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (*UnimplementedBikeRentalServiceServer) DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromoCode not implemented")
+}
+
+func RegisterBikeRentalServiceServer(s *grpc.Server, srv BikeRentalServiceServer) {
+	s.RegisterService(&_BikeRentalService_serviceDesc, srv)
+}
+
+func _BikeRentalService_ListBikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListBikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListBikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListBikes(ctx, req.(*ListBikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetBike(ctx, req.(*GetBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateBike(ctx, req.(*CreateBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_DeleteBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).DeleteBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).DeleteBike(ctx, req.(*DeleteBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_UpdateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).UpdateBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).UpdateBike(ctx, req.(*UpdateBikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetBikeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBikeAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, req.(*GetBikeAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_SearchAvailableBikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAvailableBikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).SearchAvailableBikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/SearchAvailableBikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).SearchAvailableBikes(ctx, req.(*SearchAvailableBikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListReservations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_nglogic_bikerental_v1_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   18,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_nglogic_bikerental_v1_service_proto_goTypes,
-		DependencyIndexes: file_nglogic_bikerental_v1_service_proto_depIdxs,
-		EnumInfos:         file_nglogic_bikerental_v1_service_proto_enumTypes,
-		MessageInfos:      file_nglogic_bikerental_v1_service_proto_msgTypes,
-	}.Build()
-	File_nglogic_bikerental_v1_service_proto = out.File
-	file_nglogic_bikerental_v1_service_proto_rawDesc = nil
-	file_nglogic_bikerental_v1_service_proto_goTypes = nil
-	file_nglogic_bikerental_v1_service_proto_depIdxs = nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _BikeRentalService_UpdateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).UpdateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).UpdateReservation(ctx, req.(*UpdateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _BikeRentalService_HoldBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).HoldBike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/HoldBike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).HoldBike(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// BikeRentalServiceClient is the client API for BikeRentalService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BikeRentalServiceClient interface {
-	// List all bikes.
-	ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error)
-	// Return bike by id.
-	GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error)
-	// Create new bike.
-	//
-	// Returns created object with new id.
-	CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error)
-	// Delete a bike by id.
-	DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Update a bike.
-	UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Check if bike is available.
-	GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error)
-	// List reservations.
-	//
-	// Returns list of reservations for a bike.
-	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
-	// Create reservation.
-	//
-	// Returns created object with new id.
-	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
-	// Cancel reservation.
-	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func _BikeRentalService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ConfirmReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type bikeRentalServiceClient struct {
-	cc grpc.ClientConnInterface
+func _BikeRentalService_CreateGroupReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateGroupReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateGroupReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateGroupReservation(ctx, req.(*CreateGroupReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewBikeRentalServiceClient(cc grpc.ClientConnInterface) BikeRentalServiceClient {
-	return &bikeRentalServiceClient{cc}
+func _BikeRentalService_CreateRecurringReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateRecurringReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateRecurringReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateRecurringReservation(ctx, req.(*CreateRecurringReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) ListBikes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListBikesResponse, error) {
-	out := new(ListBikesResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListBikes", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_CancelReservationSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationSeriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CancelReservationSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelReservationSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CancelReservationSeries(ctx, req.(*CancelReservationSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) GetBike(ctx context.Context, in *GetBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
-	out := new(Bike)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CreateBike(ctx context.Context, in *CreateBikeRequest, opts ...grpc.CallOption) (*Bike, error) {
-	out := new(Bike)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_StartRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRentalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).StartRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/StartRental",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).StartRental(ctx, req.(*StartRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) DeleteBike(ctx context.Context, in *DeleteBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/DeleteBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_CompleteRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CompleteRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CompleteRental",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CompleteRental(ctx, req.(*CompleteRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/MarkNoShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).MarkNoShow(ctx, req.(*MarkNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/JoinWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).JoinWaitlist(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListWaitlist(ctx, req.(*ListWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_WithdrawWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawWaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).WithdrawWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/WithdrawWaitlistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).WithdrawWaitlistEntry(ctx, req.(*WithdrawWaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BikeRentalService_ListMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListMaintenance(ctx, req.(*ListMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) UpdateBike(ctx context.Context, in *UpdateBikeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/UpdateBike", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) GetBikeAvailability(ctx context.Context, in *GetBikeAvailabilityRequest, opts ...grpc.CallOption) (*GetBikeAvailabilityResponse, error) {
-	out := new(GetBikeAvailabilityResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_CancelMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CancelMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CancelMaintenance(ctx, req.(*CancelMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
-	out := new(ListReservationsResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/ListReservations", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_CheckDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDiscountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CheckDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CheckDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CheckDiscount(ctx, req.(*CheckDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
-	out := new(CreateReservationResponse)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CreateReservation", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_ListStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).ListStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListStations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).ListStations(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bikeRentalServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/nglogic.bikerental.v1.BikeRentalService/CancelReservation", in, out, opts...)
-	if err != nil {
+func _BikeRentalService_GetStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// BikeRentalServiceServer is the server API for BikeRentalService service.
-type BikeRentalServiceServer interface {
-	// List all bikes.
-	ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error)
-	// Return bike by id.
-	GetBike(context.Context, *GetBikeRequest) (*Bike, error)
-	// Create new bike.
-	//
-	// Returns created object with new id.
-	CreateBike(context.Context, *CreateBikeRequest) (*Bike, error)
-	// Delete a bike by id.
-	DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error)
-	// Update a bike.
-	UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error)
-	// Check if bike is available.
-	GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error)
-	// List reservations.
-	//
-	// Returns list of reservations for a bike.
-	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
-	// Create reservation.
-	//
-	// Returns created object with new id.
-	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
-	// Cancel reservation.
-	CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error)
-}
-
-// UnimplementedBikeRentalServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedBikeRentalServiceServer struct {
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).GetStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetStation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).GetStation(ctx, req.(*GetStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedBikeRentalServiceServer) ListBikes(context.Context, *empty.Empty) (*ListBikesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBikes not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) GetBike(context.Context, *GetBikeRequest) (*Bike, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CreateBike(context.Context, *CreateBikeRequest) (*Bike, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) DeleteBike(context.Context, *DeleteBikeRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) UpdateBike(context.Context, *UpdateBikeRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBike not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) GetBikeAvailability(context.Context, *GetBikeAvailabilityRequest) (*GetBikeAvailabilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBikeAvailability not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
-}
-func (*UnimplementedBikeRentalServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+func _BikeRentalService_CreateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).CreateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateStation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).CreateStation(ctx, req.(*CreateStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBikeRentalServiceServer(s *grpc.Server, srv BikeRentalServiceServer) {
-	s.RegisterService(&_BikeRentalService_serviceDesc, srv)
+func _BikeRentalService_UpdateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BikeRentalServiceServer).UpdateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateStation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BikeRentalServiceServer).UpdateStation(ctx, req.(*UpdateStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_ListBikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _BikeRentalService_DeleteStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).ListBikes(ctx, in)
+		return srv.(BikeRentalServiceServer).DeleteStation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListBikes",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteStation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).ListBikes(ctx, req.(*empty.Empty))
+		return srv.(BikeRentalServiceServer).DeleteStation(ctx, req.(*DeleteStationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_GetBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBikeRequest)
+func _BikeRentalService_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).GetBike(ctx, in)
+		return srv.(BikeRentalServiceServer).ListCustomers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListCustomers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).GetBike(ctx, req.(*GetBikeRequest))
+		return srv.(BikeRentalServiceServer).ListCustomers(ctx, req.(*ListCustomersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CreateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBikeRequest)
+func _BikeRentalService_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CreateBike(ctx, in)
+		return srv.(BikeRentalServiceServer).GetCustomer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetCustomer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CreateBike(ctx, req.(*CreateBikeRequest))
+		return srv.(BikeRentalServiceServer).GetCustomer(ctx, req.(*GetCustomerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_DeleteBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBikeRequest)
+func _BikeRentalService_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).DeleteBike(ctx, in)
+		return srv.(BikeRentalServiceServer).CreateCustomer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateCustomer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).DeleteBike(ctx, req.(*DeleteBikeRequest))
+		return srv.(BikeRentalServiceServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_UpdateBike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBikeRequest)
+func _BikeRentalService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).UpdateBike(ctx, in)
+		return srv.(BikeRentalServiceServer).UpdateCustomer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateBike",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/UpdateCustomer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).UpdateBike(ctx, req.(*UpdateBikeRequest))
+		return srv.(BikeRentalServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_GetBikeAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBikeAvailabilityRequest)
+func _BikeRentalService_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, in)
+		return srv.(BikeRentalServiceServer).DeleteCustomer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/GetBikeAvailability",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DeleteCustomer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).GetBikeAvailability(ctx, req.(*GetBikeAvailabilityRequest))
+		return srv.(BikeRentalServiceServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReservationsRequest)
+func _BikeRentalService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).ListReservations(ctx, in)
+		return srv.(BikeRentalServiceServer).ListPromoCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListReservations",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/ListPromoCodes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+		return srv.(BikeRentalServiceServer).ListPromoCodes(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReservationRequest)
+func _BikeRentalService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CreateReservation(ctx, in)
+		return srv.(BikeRentalServiceServer).CreatePromoCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreateReservation",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CreatePromoCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+		return srv.(BikeRentalServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BikeRentalService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelReservationRequest)
+func _BikeRentalService_DisablePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePromoCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BikeRentalServiceServer).CancelReservation(ctx, in)
+		return srv.(BikeRentalServiceServer).DisablePromoCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/CancelReservation",
+		FullMethod: "/nglogic.bikerental.v1.BikeRentalService/DisablePromoCode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BikeRentalServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+		return srv.(BikeRentalServiceServer).DisablePromoCode(ctx, req.(*DisablePromoCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2161,6 +8452,10 @@ var _BikeRentalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBikeAvailability",
 			Handler:    _BikeRentalService_GetBikeAvailability_Handler,
 		},
+		{
+			MethodName: "SearchAvailableBikes",
+			Handler:    _BikeRentalService_SearchAvailableBikes_Handler,
+		},
 		{
 			MethodName: "ListReservations",
 			Handler:    _BikeRentalService_ListReservations_Handler,
@@ -2169,10 +8464,130 @@ var _BikeRentalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateReservation",
 			Handler:    _BikeRentalService_CreateReservation_Handler,
 		},
+		{
+			MethodName: "UpdateReservation",
+			Handler:    _BikeRentalService_UpdateReservation_Handler,
+		},
+		{
+			MethodName: "HoldBike",
+			Handler:    _BikeRentalService_HoldBike_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _BikeRentalService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "CreateGroupReservation",
+			Handler:    _BikeRentalService_CreateGroupReservation_Handler,
+		},
+		{
+			MethodName: "CreateRecurringReservation",
+			Handler:    _BikeRentalService_CreateRecurringReservation_Handler,
+		},
+		{
+			MethodName: "CancelReservationSeries",
+			Handler:    _BikeRentalService_CancelReservationSeries_Handler,
+		},
 		{
 			MethodName: "CancelReservation",
 			Handler:    _BikeRentalService_CancelReservation_Handler,
 		},
+		{
+			MethodName: "StartRental",
+			Handler:    _BikeRentalService_StartRental_Handler,
+		},
+		{
+			MethodName: "CompleteRental",
+			Handler:    _BikeRentalService_CompleteRental_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _BikeRentalService_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _BikeRentalService_GetInvoice_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BikeRentalService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "ListWaitlist",
+			Handler:    _BikeRentalService_ListWaitlist_Handler,
+		},
+		{
+			MethodName: "WithdrawWaitlistEntry",
+			Handler:    _BikeRentalService_WithdrawWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "ListMaintenance",
+			Handler:    _BikeRentalService_ListMaintenance_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _BikeRentalService_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelMaintenance",
+			Handler:    _BikeRentalService_CancelMaintenance_Handler,
+		},
+		{
+			MethodName: "CheckDiscount",
+			Handler:    _BikeRentalService_CheckDiscount_Handler,
+		},
+		{
+			MethodName: "ListStations",
+			Handler:    _BikeRentalService_ListStations_Handler,
+		},
+		{
+			MethodName: "GetStation",
+			Handler:    _BikeRentalService_GetStation_Handler,
+		},
+		{
+			MethodName: "CreateStation",
+			Handler:    _BikeRentalService_CreateStation_Handler,
+		},
+		{
+			MethodName: "UpdateStation",
+			Handler:    _BikeRentalService_UpdateStation_Handler,
+		},
+		{
+			MethodName: "DeleteStation",
+			Handler:    _BikeRentalService_DeleteStation_Handler,
+		},
+		{
+			MethodName: "ListCustomers",
+			Handler:    _BikeRentalService_ListCustomers_Handler,
+		},
+		{
+			MethodName: "GetCustomer",
+			Handler:    _BikeRentalService_GetCustomer_Handler,
+		},
+		{
+			MethodName: "CreateCustomer",
+			Handler:    _BikeRentalService_CreateCustomer_Handler,
+		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _BikeRentalService_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "DeleteCustomer",
+			Handler:    _BikeRentalService_DeleteCustomer_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _BikeRentalService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _BikeRentalService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "DisablePromoCode",
+			Handler:    _BikeRentalService_DisablePromoCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nglogic/bikerental/v1/service.proto",